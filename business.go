@@ -0,0 +1,223 @@
+package synchro
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// HolidayCalendar is the interface implemented by a calendar of holidays
+// in the timezone T.
+type HolidayCalendar[T TimeZone] interface {
+	// IsHoliday reports whether d is a holiday.
+	IsHoliday(d Date[T]) bool
+}
+
+// HolidayFunc is an adapter to allow the use of ordinary functions as
+// HolidayCalendar.
+type HolidayFunc[T TimeZone] func(d Date[T]) bool
+
+var _ HolidayCalendar[tz.UTC] = HolidayFunc[tz.UTC](nil)
+
+// IsHoliday calls f(d).
+func (f HolidayFunc[T]) IsHoliday(d Date[T]) bool { return f(d) }
+
+// HolidaySet is an in-memory HolidayCalendar which consists of a set of dates.
+//
+// The zero value of HolidaySet is an empty set ready to use.
+// HolidaySet is not safe for concurrent writes.
+type HolidaySet[T TimeZone] struct {
+	dates map[Date[T]]struct{}
+}
+
+var _ HolidayCalendar[tz.UTC] = (*HolidaySet[tz.UTC])(nil)
+
+// NewHolidaySet returns a HolidaySet which contains the given dates.
+func NewHolidaySet[T TimeZone](dates ...Date[T]) *HolidaySet[T] {
+	s := new(HolidaySet[T])
+	s.Add(dates...)
+	return s
+}
+
+// Add adds the dates to s.
+func (s *HolidaySet[T]) Add(dates ...Date[T]) {
+	if s.dates == nil {
+		s.dates = make(map[Date[T]]struct{}, len(dates))
+	}
+	for _, d := range dates {
+		s.dates[d] = struct{}{}
+	}
+}
+
+// Remove removes the dates from s.
+func (s *HolidaySet[T]) Remove(dates ...Date[T]) {
+	for _, d := range dates {
+		delete(s.dates, d)
+	}
+}
+
+// Len returns the number of dates in s.
+func (s *HolidaySet[T]) Len() int { return len(s.dates) }
+
+// IsHoliday reports whether d is in s.
+func (s *HolidaySet[T]) IsHoliday(d Date[T]) bool {
+	_, ok := s.dates[d]
+	return ok
+}
+
+// UnionHolidays returns a HolidayCalendar which reports a date as a holiday
+// if any of the calendars reports it as a holiday.
+func UnionHolidays[T TimeZone](calendars ...HolidayCalendar[T]) HolidayCalendar[T] {
+	return HolidayFunc[T](func(d Date[T]) bool {
+		for _, c := range calendars {
+			if c.IsHoliday(d) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Weekend is a set of days of the week which are not business days.
+type Weekend uint8
+
+const (
+	// WeekendSaturdaySunday is the weekend of Saturday and Sunday.
+	WeekendSaturdaySunday = Weekend(1<<time.Saturday | 1<<time.Sunday)
+	// WeekendFridaySaturday is the weekend of Friday and Saturday, which
+	// is common in the Middle East.
+	WeekendFridaySaturday = Weekend(1<<time.Friday | 1<<time.Saturday)
+)
+
+// NewWeekend returns the Weekend which consists of the given days.
+func NewWeekend(days ...time.Weekday) Weekend {
+	var w Weekend
+	for _, d := range days {
+		w |= 1 << d
+	}
+	return w
+}
+
+// Contains reports whether d is in w.
+func (w Weekend) Contains(d time.Weekday) bool {
+	return w&(1<<d) != 0
+}
+
+// BusinessCalendar determines business days in the timezone T from
+// a weekend and holidays. A business day is a day which is neither
+// a weekend day nor a holiday.
+//
+// The zero value of BusinessCalendar has no weekend days and no holidays.
+type BusinessCalendar[T TimeZone] struct {
+	weekend  Weekend
+	holidays HolidayCalendar[T]
+}
+
+// NewBusinessCalendar returns a BusinessCalendar with the given weekend and holidays.
+// If more than one holiday calendar is given, they are combined with UnionHolidays.
+//
+// If the weekend contains all days of the week, the calendar has no business days.
+func NewBusinessCalendar[T TimeZone](weekend Weekend, holidays ...HolidayCalendar[T]) BusinessCalendar[T] {
+	c := BusinessCalendar[T]{weekend: weekend}
+	switch len(holidays) {
+	case 0:
+	case 1:
+		c.holidays = holidays[0]
+	default:
+		c.holidays = UnionHolidays(holidays...)
+	}
+	return c
+}
+
+// IsBusinessDay reports whether d is a business day.
+func (c BusinessCalendar[T]) IsBusinessDay(d Date[T]) bool {
+	if c.weekend.Contains(d.Weekday()) {
+		return false
+	}
+	return c.holidays == nil || !c.holidays.IsHoliday(d)
+}
+
+// IsBusinessDay reports whether t occurs on a business day in the calendar c.
+func (t Time[T]) IsBusinessDay(c BusinessCalendar[T]) bool {
+	return c.IsBusinessDay(DateOf(t))
+}
+
+// ErrNoBusinessDay is returned by AddBusinessDays and NextBusinessDay when no
+// business day is found in a full year of consecutive days, for example, if
+// the holidays of the calendar contain every day.
+var ErrNoBusinessDay = errors.New("synchro: no business day in a year of consecutive days")
+
+// maxNonBusinessDays is the maximum number of consecutive non-business days
+// searched by AddBusinessDays, which is a full year.
+const maxNonBusinessDays = 366
+
+// AddBusinessDays returns the time n business days after t in the calendar c,
+// keeping the time of day. n may be negative. If n is zero, t is returned as is.
+//
+// If t is not on a business day, the first business day after (or before if n
+// is negative) t is counted as the first one.
+//
+// ErrNoBusinessDay is returned if no business day is found in a full year
+// of consecutive days.
+func (t Time[T]) AddBusinessDays(c BusinessCalendar[T], n int) (Time[T], error) {
+	if n == 0 {
+		return t, nil
+	}
+	step := 1
+	if n < 0 {
+		n, step = -n, -1
+	}
+	d := DateOf(t)
+	for skipped := 0; n > 0; {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n--
+			skipped = 0
+		} else if skipped++; skipped >= maxNonBusinessDays {
+			return Time[T]{}, ErrNoBusinessDay
+		}
+	}
+	return d.At(t.TimeOfDay()), nil
+}
+
+// NextBusinessDay returns the time on the first business day after t in the
+// calendar c, keeping the time of day.
+//
+// ErrNoBusinessDay is returned in the same way as AddBusinessDays.
+func (t Time[T]) NextBusinessDay(c BusinessCalendar[T]) (Time[T], error) {
+	return t.AddBusinessDays(c, 1)
+}
+
+// BusinessDaysBetween returns the number of business days in the calendar c
+// from the date of t (inclusive) to the date of u (exclusive).
+// If u is before t, the result is negative.
+func (t Time[T]) BusinessDaysBetween(c BusinessCalendar[T], u Time[T]) int {
+	from, to := DateOf(t), DateOf(u)
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	n := 0
+	for d := from; d.Before(to); d = d.AddDays(1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// Holiday represents a named holiday in the timezone T.
+type Holiday[T TimeZone] struct {
+	Date Date[T]
+	Name string
+}
+
+// Start returns start time in the holiday.
+func (h Holiday[T]) Start() Time[T] { return h.Date.StartOfDay() }
+
+// End returns end time in the holiday.
+func (h Holiday[T]) End() Time[T] { return h.Date.EndOfDay() }
+
+// String returns the holiday in the format "YYYY-MM-DD Name".
+func (h Holiday[T]) String() string { return h.Date.String() + " " + h.Name }
@@ -0,0 +1,194 @@
+package synchro_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_AddBusinessDays() {
+	holidays := synchro.NewHolidaySet(
+		synchro.NewDate[tz.AsiaTokyo](2024, 5, 3),
+		synchro.NewDate[tz.AsiaTokyo](2024, 5, 6),
+	)
+	c := synchro.NewBusinessCalendar[tz.AsiaTokyo](synchro.WeekendSaturdaySunday, holidays)
+	t := synchro.New[tz.AsiaTokyo](2024, 5, 2, 10, 0, 0, 0)
+	next, err := t.AddBusinessDays(c, 1)
+	if err != nil {
+		panic(err)
+	}
+	prev, err := t.AddBusinessDays(c, -1)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(next)
+	fmt.Println(prev)
+	// Output:
+	// 2024-05-07 10:00:00 +0900 JST
+	// 2024-05-01 10:00:00 +0900 JST
+}
+
+func TestWeekend(t *testing.T) {
+	w := synchro.NewWeekend(time.Friday, time.Saturday)
+	if w != synchro.WeekendFridaySaturday {
+		t.Errorf("want %08b but got %08b", synchro.WeekendFridaySaturday, w)
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		want := d == time.Saturday || d == time.Sunday
+		if got := synchro.WeekendSaturdaySunday.Contains(d); got != want {
+			t.Errorf("%v: want %v but got %v", d, want, got)
+		}
+	}
+}
+
+func TestBusinessCalendar_NoBusinessDay(t *testing.T) {
+	everyDay := synchro.HolidayFunc[tz.UTC](func(synchro.Date[tz.UTC]) bool { return true })
+	tm := synchro.New[tz.UTC](2024, 1, 1, 9, 0, 0, 0)
+	for name, c := range map[string]synchro.BusinessCalendar[tz.UTC]{
+		"holidays": synchro.NewBusinessCalendar[tz.UTC](0, everyDay),
+		"weekend":  synchro.NewBusinessCalendar[tz.UTC](synchro.NewWeekend(0, 1, 2, 3, 4, 5, 6)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tm.IsBusinessDay(c) {
+				t.Errorf("want no business day")
+			}
+			if _, err := tm.AddBusinessDays(c, -1); !errors.Is(err, synchro.ErrNoBusinessDay) {
+				t.Errorf("AddBusinessDays: want ErrNoBusinessDay but got %v", err)
+			}
+			if _, err := tm.NextBusinessDay(c); !errors.Is(err, synchro.ErrNoBusinessDay) {
+				t.Errorf("NextBusinessDay: want ErrNoBusinessDay but got %v", err)
+			}
+		})
+	}
+
+	// Less than a year of consecutive holidays is skipped.
+	end := synchro.NewDate[tz.UTC](2024, 12, 1)
+	c := synchro.NewBusinessCalendar[tz.UTC](0, synchro.HolidayFunc[tz.UTC](func(d synchro.Date[tz.UTC]) bool {
+		return d.Before(end)
+	}))
+	got, err := tm.NextBusinessDay(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := end.At(tm.TimeOfDay()); !got.Equal(want) {
+		t.Errorf("want %v but got %v", want, got)
+	}
+}
+
+func TestHolidaySet(t *testing.T) {
+	var s synchro.HolidaySet[tz.UTC]
+	d := synchro.NewDate[tz.UTC](2024, 1, 1)
+	if s.IsHoliday(d) {
+		t.Errorf("zero value must be empty")
+	}
+	s.Add(d, d, d.AddDays(1))
+	if s.Len() != 2 || !s.IsHoliday(d) || !s.IsHoliday(d.AddDays(1)) {
+		t.Errorf("unexpected set: len=%d", s.Len())
+	}
+	s.Remove(d)
+	if s.Len() != 1 || s.IsHoliday(d) {
+		t.Errorf("unexpected set after remove: len=%d", s.Len())
+	}
+
+	a := synchro.NewHolidaySet(synchro.NewDate[tz.UTC](2024, 1, 1))
+	b := synchro.HolidayFunc[tz.UTC](func(d synchro.Date[tz.UTC]) bool {
+		return d.Month() == time.December && d.Day() == 25
+	})
+	u := synchro.UnionHolidays[tz.UTC](a, b)
+	for _, d := range []synchro.Date[tz.UTC]{
+		synchro.NewDate[tz.UTC](2024, 1, 1),
+		synchro.NewDate[tz.UTC](2024, 12, 25),
+		synchro.NewDate[tz.UTC](2030, 12, 25),
+	} {
+		if !u.IsHoliday(d) {
+			t.Errorf("want %s to be a holiday", d)
+		}
+	}
+	if u.IsHoliday(synchro.NewDate[tz.UTC](2024, 1, 2)) {
+		t.Errorf("want 2024-01-02 not to be a holiday")
+	}
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	holidays := synchro.NewHolidaySet(synchro.NewDate[NY](2024, 3, 11))
+	c := synchro.NewBusinessCalendar[NY](synchro.WeekendSaturdaySunday, holidays)
+	fri := synchro.New[NY](2024, 3, 8, 9, 30, 0, 0)
+	sat := synchro.New[NY](2024, 3, 9, 9, 30, 0, 0)
+	tue := synchro.New[NY](2024, 3, 12, 9, 30, 0, 0)
+
+	if !fri.IsBusinessDay(c) || sat.IsBusinessDay(c) || synchro.New[NY](2024, 3, 11, 0, 0, 0, 0).IsBusinessDay(c) {
+		t.Errorf("unexpected business days")
+	}
+	mustAdd := func(tm synchro.Time[NY], n int) synchro.Time[NY] {
+		got, err := tm.AddBusinessDays(c, n)
+		if err != nil {
+			panic(err)
+		}
+		return got
+	}
+	next, err := fri.NextBusinessDay(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		got  synchro.Time[NY]
+		want synchro.Time[NY]
+	}{
+		{
+			name: "next business day skips weekend, holiday and DST transition",
+			got:  next,
+			want: tue,
+		},
+		{
+			name: "from weekend",
+			got:  mustAdd(sat, 1),
+			want: tue,
+		},
+		{
+			name: "backward from weekend",
+			got:  mustAdd(sat, -1),
+			want: fri,
+		},
+		{
+			name: "backward",
+			got:  mustAdd(tue, -2),
+			want: synchro.New[NY](2024, 3, 7, 9, 30, 0, 0),
+		},
+		{
+			name: "zero",
+			got:  mustAdd(sat, 0),
+			want: sat,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.want.Equal(tt.got) {
+				t.Errorf("want %v but got %v", tt.want, tt.got)
+			}
+		})
+	}
+
+	if got := fri.BusinessDaysBetween(c, tue); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := tue.BusinessDaysBetween(c, fri); got != -1 {
+		t.Errorf("want -1 but got %d", got)
+	}
+	if got := fri.BusinessDaysBetween(c, fri.Add(time.Hour)); got != 0 {
+		t.Errorf("want 0 but got %d", got)
+	}
+	friday := synchro.NewBusinessCalendar[NY](synchro.WeekendFridaySaturday)
+	if got := fri.BusinessDaysBetween(friday, tue); got != 2 {
+		t.Errorf("want 2 but got %d", got)
+	}
+	var zero synchro.BusinessCalendar[NY]
+	if !sat.IsBusinessDay(zero) {
+		t.Errorf("zero value calendar has no weekend")
+	}
+}
@@ -0,0 +1,210 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// Date represents a calendar date (year, month, day) in the timezone T.
+// Unlike Time[T], Date has no clock. It is useful for values such as
+// billing dates, birthdays or due dates.
+//
+// The zero value of Date represents January 1, year 1, which is
+// the same date as the zero value of Time[T].
+type Date[T TimeZone] struct {
+	// tm is always midnight of the date in UTC.
+	tm time.Time
+	_  empty[T]
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*Date[tz.UTC])(nil)
+
+// NewDate returns the Date corresponding to yyyy-mm-dd in the given timezone.
+//
+// The month and day values may be outside their usual ranges and will be
+// normalized during the conversion. For example, October 32 converts to November 1.
+func NewDate[T TimeZone](year int, month time.Month, day int) Date[T] {
+	return Date[T]{tm: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the calendar date on which t occurs in the timezone T.
+func DateOf[T TimeZone](t Time[T]) Date[T] {
+	return NewDate[T](t.Date())
+}
+
+// DateFromISO returns the Date corresponding to the given ISO 8601 date
+// representation (calendar, ordinal, week or quarter date).
+func DateFromISO[T TimeZone](d iso8601.DateLike) Date[T] {
+	date := d.Date()
+	return NewDate[T](date.Year, date.Month, date.Day)
+}
+
+// ParseDate parses an ISO8601-compliant date string and returns the Date it represents.
+// Supported formats include:
+//
+//	Basic           Extended
+//	20121224        2012-12-24    Calendar date   (ISO 8601)
+//	2012359         2012-359      Ordinal date    (ISO 8601)
+//	2012W521        2012-W52-1    Week date       (ISO 8601)
+//	2012Q485        2012-Q4-85    Quarter date
+func ParseDate[T TimeZone](value string) (Date[T], error) {
+	d, err := iso8601.ParseDate(value)
+	if err != nil {
+		return Date[T]{}, err
+	}
+	return DateFromISO[T](d), nil
+}
+
+// Date returns the year, month, and day of d.
+func (d Date[T]) Date() (year int, month time.Month, day int) {
+	return d.tm.Date()
+}
+
+// Year returns the year of d.
+func (d Date[T]) Year() int { return d.tm.Year() }
+
+// Month returns the month of the year of d.
+func (d Date[T]) Month() time.Month { return d.tm.Month() }
+
+// Day returns the day of the month of d.
+func (d Date[T]) Day() int { return d.tm.Day() }
+
+// Weekday returns the day of the week of d.
+func (d Date[T]) Weekday() time.Weekday { return d.tm.Weekday() }
+
+// YearDay returns the day of the year of d, in the range [1,365] for non-leap years,
+// and [1,366] in leap years.
+func (d Date[T]) YearDay() int { return d.tm.YearDay() }
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+func (d Date[T]) ISOWeek() (year, week int) { return d.tm.ISOWeek() }
+
+// IsZero reports whether d represents the zero date, January 1, year 1.
+func (d Date[T]) IsZero() bool { return d.tm.IsZero() }
+
+// AddDate returns the date corresponding to adding the given number of
+// years, months, and days to d.
+//
+// AddDate normalizes its result in the same way that time.Time.AddDate does,
+// so, for example, adding one month to October 31 yields December 1.
+func (d Date[T]) AddDate(years int, months int, days int) Date[T] {
+	return Date[T]{tm: d.tm.AddDate(years, months, days)}
+}
+
+// AddDays returns the date n days after d. n may be negative.
+func (d Date[T]) AddDays(n int) Date[T] { return d.AddDate(0, 0, n) }
+
+// AddMonths returns the date n months after d. n may be negative.
+// The result is normalized in the same way as AddDate.
+func (d Date[T]) AddMonths(n int) Date[T] { return d.AddDate(0, n, 0) }
+
+// AddYears returns the date n years after d. n may be negative.
+// The result is normalized in the same way as AddDate.
+func (d Date[T]) AddYears(n int) Date[T] { return d.AddDate(n, 0, 0) }
+
+// Sub returns the number of days d-u.
+func (d Date[T]) Sub(u Date[T]) int {
+	const secondsPerDay = 24 * 60 * 60
+	return int((d.tm.Unix() - u.tm.Unix()) / secondsPerDay)
+}
+
+// After reports whether d is after u.
+func (d Date[T]) After(u Date[T]) bool { return d.tm.After(u.tm) }
+
+// Before reports whether d is before u.
+func (d Date[T]) Before(u Date[T]) bool { return d.tm.Before(u.tm) }
+
+// Equal reports whether d and u represent the same date.
+func (d Date[T]) Equal(u Date[T]) bool { return d.tm.Equal(u.tm) }
+
+// Compare compares d with u. If d is before u, it returns -1;
+// if d is after u, it returns +1; if they're the same, it returns 0.
+func (d Date[T]) Compare(u Date[T]) int { return d.tm.Compare(u.tm) }
+
+// StartOfDay returns the first instant of d in the timezone T.
+//
+// This is usually midnight. However, in some time zones a daylight
+// saving time transition skips midnight (e.g. America/Sao_Paulo in 2018).
+// In such cases, the first instant that exists on that day is returned.
+func (d Date[T]) StartOfDay() Time[T] {
+	var tz T
+	year, month, day := d.Date()
+	tm := time.Date(year, month, day, 0, 0, 0, 0, tz.Location())
+	if y, m, dd := tm.Date(); y != year || m != month || dd != day {
+		// time.Date moved back to the previous day because midnight does
+		// not exist. The day starts when the next zone begins.
+		if _, end := tm.ZoneBounds(); !end.IsZero() {
+			tm = end
+		}
+	}
+	return Time[T]{tm: tm}
+}
+
+// EndOfDay returns the last instant of d in the timezone T.
+func (d Date[T]) EndOfDay() Time[T] {
+	return d.AddDays(1).StartOfDay().Add(-1 * time.Nanosecond)
+}
+
+// ISODate returns d as iso8601.Date.
+func (d Date[T]) ISODate() iso8601.Date {
+	year, month, day := d.Date()
+	return iso8601.Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+}
+
+// String returns the ISO8601 string representation of the format "YYYY-MM-DD".
+// For example: "2012-12-01".
+func (d Date[T]) String() string {
+	return d.tm.Format(time.DateOnly)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The date is formatted as "YYYY-MM-DD".
+func (d Date[T]) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The date must be in a format supported by ParseDate.
+func (d *Date[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseDate[T](string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is a quoted string in the "YYYY-MM-DD" format.
+func (d Date[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date must be a quoted string in a format supported by ParseDate.
+func (d *Date[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,209 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleDateOf() {
+	t := synchro.New[tz.AsiaTokyo](2023, 9, 2, 23, 30, 0, 0)
+	d := synchro.DateOf(t)
+	fmt.Println(d)
+	fmt.Println(d.StartOfDay())
+	// Output:
+	// 2023-09-02
+	// 2023-09-02 00:00:00 +0900 JST
+}
+
+func ExampleDate_Sub() {
+	d1 := synchro.NewDate[tz.UTC](2024, 3, 1)
+	d2 := synchro.NewDate[tz.UTC](2024, 2, 1)
+	fmt.Println(d1.Sub(d2))
+	fmt.Println(d2.Sub(d1))
+	// Output:
+	// 29
+	// -29
+}
+
+func TestNewDate(t *testing.T) {
+	d := synchro.NewDate[tz.UTC](2023, 10, 32)
+	if y, m, dd := d.Date(); y != 2023 || m != time.November || dd != 1 {
+		t.Errorf("want 2023-11-01 but got %d-%d-%d", y, m, dd)
+	}
+	if want := time.Wednesday; d.Weekday() != want {
+		t.Errorf("want %v but got %v", want, d.Weekday())
+	}
+	if want := 305; d.YearDay() != want {
+		t.Errorf("want %d but got %d", want, d.YearDay())
+	}
+	if !(synchro.Date[tz.UTC]{}).IsZero() {
+		t.Errorf("zero value should be zero")
+	}
+	if want := synchro.DateOf(synchro.Time[tz.UTC]{}); !want.IsZero() {
+		t.Errorf("date of zero time should be zero, but got %s", want)
+	}
+}
+
+func TestDate_Add(t *testing.T) {
+	d := synchro.NewDate[tz.UTC](2024, 1, 31)
+	tests := []struct {
+		name string
+		got  synchro.Date[tz.UTC]
+		want synchro.Date[tz.UTC]
+	}{
+		{
+			name: "AddDays",
+			got:  d.AddDays(1),
+			want: synchro.NewDate[tz.UTC](2024, 2, 1),
+		},
+		{
+			name: "AddDays negative",
+			got:  d.AddDays(-31),
+			want: synchro.NewDate[tz.UTC](2023, 12, 31),
+		},
+		{
+			name: "AddMonths",
+			got:  d.AddMonths(2),
+			want: synchro.NewDate[tz.UTC](2024, 3, 31),
+		},
+		{
+			name: "AddMonths normalized",
+			got:  d.AddMonths(1),
+			want: synchro.NewDate[tz.UTC](2024, 3, 2),
+		},
+		{
+			name: "AddYears",
+			got:  d.AddYears(-1),
+			want: synchro.NewDate[tz.UTC](2023, 1, 31),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.want.Equal(tt.got) {
+				t.Errorf("want %s but got %s", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	d1 := synchro.NewDate[tz.UTC](2024, 1, 1)
+	d2 := synchro.NewDate[tz.UTC](2024, 1, 2)
+	if !d1.Before(d2) || d2.Before(d1) {
+		t.Errorf("%s should be before %s", d1, d2)
+	}
+	if !d2.After(d1) || d1.After(d2) {
+		t.Errorf("%s should be after %s", d2, d1)
+	}
+	if got := d1.Compare(d2); got != -1 {
+		t.Errorf("want -1 but got %d", got)
+	}
+	if got := d1.Compare(d1); got != 0 {
+		t.Errorf("want 0 but got %d", got)
+	}
+	if d1 != synchro.NewDate[tz.UTC](2024, 1, 1) {
+		t.Errorf("same dates should be comparable with ==")
+	}
+}
+
+func TestDate_StartOfDay(t *testing.T) {
+	t.Run("Asia/Tokyo", func(t *testing.T) {
+		d := synchro.NewDate[tz.AsiaTokyo](2023, 9, 2)
+		want := synchro.New[tz.AsiaTokyo](2023, 9, 2, 0, 0, 0, 0)
+		if got := d.StartOfDay(); !want.Equal(got) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		wantEnd := synchro.New[tz.AsiaTokyo](2023, 9, 2, 23, 59, 59, 999999999)
+		if got := d.EndOfDay(); !wantEnd.Equal(got) {
+			t.Errorf("want %s but got %s", wantEnd, got)
+		}
+	})
+	t.Run("midnight does not exist", func(t *testing.T) {
+		// In 2018, DST in Sao Paulo started at midnight on November 4.
+		d := synchro.NewDate[tz.AmericaSao_Paulo](2018, 11, 4)
+		got := d.StartOfDay()
+		if got.Day() != 4 || got.Hour() != 1 {
+			t.Errorf("want 2018-11-04 01:00 but got %s", got)
+		}
+		if back := synchro.DateOf(got); !back.Equal(d) {
+			t.Errorf("want %s but got %s", d, back)
+		}
+		end := synchro.NewDate[tz.AmericaSao_Paulo](2018, 11, 3).EndOfDay()
+		if want := got.Add(-1 * time.Nanosecond); !want.Equal(end) {
+			t.Errorf("want %s but got %s", want, end)
+		}
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.Date[tz.UTC]
+		wantErr bool
+	}{
+		{value: "2012-12-24", want: synchro.NewDate[tz.UTC](2012, 12, 24)},
+		{value: "20121224", want: synchro.NewDate[tz.UTC](2012, 12, 24)},
+		{value: "2012-359", want: synchro.NewDate[tz.UTC](2012, 12, 24)},
+		{value: "2012-W52-1", want: synchro.NewDate[tz.UTC](2012, 12, 24)},
+		{value: "2012-Q4-85", want: synchro.NewDate[tz.UTC](2012, 12, 24)},
+		{value: "2012-13-24", wantErr: true},
+		{value: "2012-12-24T00:00:00", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseDate[tz.UTC](tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want.Equal(got) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDate_ISODate(t *testing.T) {
+	d := synchro.NewDate[tz.UTC](2012, 12, 24)
+	want := iso8601.Date{Year: 2012, Month: 12, Day: 24}
+	if got := d.ISODate(); got != want {
+		t.Errorf("want %v but got %v", want, got)
+	}
+	if got := synchro.DateFromISO[tz.UTC](iso8601.WeekDate{Year: 2012, Week: 52, Day: 1}); !got.Equal(d) {
+		t.Errorf("want %s but got %s", d, got)
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	type payload struct {
+		Due synchro.Date[tz.AsiaTokyo] `json:"due"`
+	}
+	b, err := json.Marshal(payload{Due: synchro.NewDate[tz.AsiaTokyo](2024, 2, 29)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"due":"2024-02-29"}`; string(b) != want {
+		t.Errorf("want %s but got %s", want, b)
+	}
+	var got payload
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.NewDate[tz.AsiaTokyo](2024, 2, 29); !want.Equal(got.Due) {
+		t.Errorf("want %s but got %s", want, got.Due)
+	}
+	if err := json.Unmarshal([]byte(`{"due":"2024-02-30"}`), &got); err == nil {
+		t.Errorf("expected error")
+	}
+}
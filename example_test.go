@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
 	"github.com/Code-Hex/synchro/tz"
 )
 
@@ -390,3 +391,24 @@ func ExampleAfter() {
 	}
 	// Output: timed out
 }
+
+func ExampleTime_FormatISO() {
+	t := synchro.New[tz.AsiaTokyo](2012, 12, 24, 9, 5, 7, 500000000)
+	fmt.Println(t.FormatISO())
+	fmt.Println(t.FormatISO(iso8601.WithBasicFormat(), iso8601.WithFractionDigits(3)))
+	fmt.Println(t.FormatISO(iso8601.WithDateForm(iso8601.WeekDateForm), iso8601.WithPrecision(iso8601.PrecisionMinute)))
+	fmt.Println(t.FormatISO(iso8601.WithDateForm(iso8601.OrdinalDateForm), iso8601.WithPrecision(iso8601.PrecisionDay)))
+	// Output:
+	// 2012-12-24T09:05:07.5+09:00
+	// 20121224T090507.500+0900
+	// 2012-W52-1T09:05+09:00
+	// 2012-359
+}
+
+func ExampleTime_AppendFormatISO() {
+	t := synchro.New[tz.AsiaTokyo](2012, 12, 24, 9, 5, 7, 500000000)
+	b := []byte("updated_at=")
+	b = t.AppendFormatISO(b, iso8601.WithPrecision(iso8601.PrecisionSecond), iso8601.WithFractionDigits(0))
+	fmt.Println(string(b))
+	// Output: updated_at=2012-12-24T09:05:07+09:00
+}
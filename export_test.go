@@ -5,3 +5,5 @@ import "time"
 func SetNow(f func() time.Time) {
 	nowFunc = f
 }
+
+var JapaneseEquinoxDay = jpEquinoxDay
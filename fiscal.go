@@ -0,0 +1,400 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// FiscalYearNaming represents how a fiscal year is labelled when it spans
+// two calendar years.
+type FiscalYearNaming int
+
+const (
+	// FiscalYearNamedByStart labels a fiscal year with the calendar year in which
+	// it starts. For example, April 2024 to March 2025 is FY2024. It is common
+	// in Japan and India.
+	FiscalYearNamedByStart FiscalYearNaming = iota
+	// FiscalYearNamedByEnd labels a fiscal year with the calendar year in which
+	// it ends. For example, October 2024 to September 2025 is FY2025. It is used
+	// by the US federal government.
+	FiscalYearNamedByEnd
+)
+
+// FiscalCalendar represents a fiscal year which starts on the first day of StartMonth.
+//
+// The zero value of FiscalCalendar represents the calendar year, which starts in January.
+//
+//	jp := synchro.FiscalCalendar{StartMonth: time.April}
+//	us := synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}
+type FiscalCalendar struct {
+	StartMonth time.Month
+	Naming     FiscalYearNaming
+}
+
+// normalize returns the canonical form of fc so that equivalent calendars are
+// equal with ==. A fiscal year which starts in January is the calendar year
+// regardless of the naming.
+func (fc FiscalCalendar) normalize() FiscalCalendar {
+	if fc.StartMonth <= time.January || fc.StartMonth > time.December {
+		return FiscalCalendar{}
+	}
+	return fc
+}
+
+// startMonth returns the first month of the fiscal year.
+func (fc FiscalCalendar) startMonth() time.Month {
+	if fc = fc.normalize(); fc.StartMonth == 0 {
+		return time.January
+	}
+	return fc.StartMonth
+}
+
+// labelOffset returns the difference between the label of a fiscal year and
+// the calendar year in which it starts.
+func (fc FiscalCalendar) labelOffset() int {
+	if fc = fc.normalize(); fc.StartMonth != 0 && fc.Naming == FiscalYearNamedByEnd {
+		return 1
+	}
+	return 0
+}
+
+// fiscalYear returns the fiscal year which contains the given calendar year and month,
+// and the zero-based index of the month in the fiscal year.
+func (fc FiscalCalendar) fiscalYear(year int, month time.Month) (fy int, index int) {
+	start := fc.startMonth()
+	index = (int(month) - int(start) + 12) % 12
+	fy = year + fc.labelOffset()
+	if month < start {
+		fy--
+	}
+	return fy, index
+}
+
+// yearMonth returns the calendar year and month of the index-th (zero-based)
+// month in the fiscal year fy.
+func (fc FiscalCalendar) yearMonth(fy int, index int) (int, time.Month) {
+	ym := time.Date(fy-fc.labelOffset(), fc.startMonth()+time.Month(index), 1, 0, 0, 0, 0, time.UTC)
+	return ym.Year(), ym.Month()
+}
+
+// months returns the number of months from January of year 0 to the
+// index-th (zero-based) month in the fiscal year fy. It is used to compare
+// periods in different fiscal calendars.
+func (fc FiscalCalendar) months(fy int, index int) int {
+	return (fy-fc.labelOffset())*12 + int(fc.startMonth()-time.January) + index
+}
+
+// formatPeriod returns the representation of the number-th period in the
+// fiscal year, such as "2024-Q3" or "FY2024-Q3". If withStart is true, the
+// year and month in which the fiscal year starts are appended after '@',
+// such as "FY2024-Q3@2024-04", so that the fiscal calendar can be parsed
+// by parseFiscalPeriod.
+func (fc FiscalCalendar) formatPeriod(year, number int, designator byte, withStart bool) string {
+	if fc == (FiscalCalendar{}) {
+		return fmt.Sprintf("%04d-%c%d", year, designator, number)
+	}
+	if !withStart {
+		return fmt.Sprintf("FY%04d-%c%d", year, designator, number)
+	}
+	startYear, startMonth := fc.yearMonth(year, 0)
+	return fmt.Sprintf("FY%04d-%c%d@%04d-%02d", year, designator, number, startYear, startMonth)
+}
+
+// parseFiscalPeriod parses the period in a year, such as "2024-Q3", or the
+// period in a fiscal year, such as "FY2024-Q3@2024-04", where "2024-04" is
+// the year and month in which the fiscal year starts.
+func parseFiscalPeriod(value string, designator byte, expected string) (fc FiscalCalendar, year, number int, err error) {
+	if !strings.HasPrefix(value, "FY") {
+		year, number, err := parseYearPeriod(value, designator, 1, expected)
+		return FiscalCalendar{}, year, number, err
+	}
+	at := strings.IndexByte(value, '@')
+	if at < 0 {
+		return FiscalCalendar{}, 0, 0, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			AfterToken: value,
+			Expected:   "'@' and the start of the fiscal year",
+			Offset:     len(value),
+			Kind:       iso8601.KindUnexpectedEnd,
+		}
+	}
+	year, number, err = parseYearPeriod(value[2:at], designator, 1, expected)
+	if err != nil {
+		if e, ok := err.(*iso8601.UnexpectedTokenError); ok {
+			e.Value = value
+			e.Offset += 2 // FY
+			e.AfterToken = value[:e.Offset]
+		}
+		return FiscalCalendar{}, 0, 0, err
+	}
+	fc, err = parseFiscalStart(value, at, year)
+	if err != nil {
+		return FiscalCalendar{}, 0, 0, err
+	}
+	return fc, year, number, nil
+}
+
+// parseFiscalStart parses the start of the fiscal year labelled year, which
+// is value[at+1:] such as "2024-04", and returns its fiscal calendar.
+func parseFiscalStart(value string, at, year int) (FiscalCalendar, error) {
+	start := value[at+1:]
+	if len(start) != 7 || !isDigits(start[:4]) || start[4] != '-' || !isDigits(start[5:]) {
+		return FiscalCalendar{}, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			Token:      start,
+			AfterToken: value[:at+1],
+			Expected:   "YYYY-MM",
+			Offset:     at + 1,
+			Length:     len(start),
+			Kind:       unexpectedKind(start),
+		}
+	}
+	startYear, _ := strconv.Atoi(start[:4])
+	startMonth, _ := strconv.Atoi(start[5:])
+	if startMonth < 1 || startMonth > 12 {
+		return FiscalCalendar{}, &iso8601.DateLikeRangeError{
+			Element: "month",
+			Value:   startMonth,
+			Year:    startYear,
+			Min:     1,
+			Max:     12,
+			Offset:  at + 6,
+			Length:  2,
+		}
+	}
+	fc := FiscalCalendar{StartMonth: time.Month(startMonth)}
+	switch year - startYear {
+	case 0:
+	case 1:
+		fc.Naming = FiscalYearNamedByEnd
+	default:
+		return FiscalCalendar{}, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			Token:      start[:4],
+			AfterToken: value[:at+1],
+			Expected:   fmt.Sprintf("%04d or %04d", year, year-1),
+			Offset:     at + 1,
+			Length:     4,
+			Kind:       iso8601.KindUnexpectedToken,
+		}
+	}
+	return fc.normalize(), nil
+}
+
+// FiscalYear represents a fiscal year in the timezone T, such as FY2024.
+//
+// FiscalYear values can be compared with == and used as map keys.
+type FiscalYear[T TimeZone] struct {
+	year int
+	fc   FiscalCalendar
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*FiscalYear[tz.UTC])(nil)
+
+// NewFiscalYear returns the FiscalYear labelled year in the fiscal calendar fc.
+func NewFiscalYear[T TimeZone](fc FiscalCalendar, year int) FiscalYear[T] {
+	return FiscalYear[T]{year: year, fc: fc.normalize()}
+}
+
+// ParseFiscalYear parses a fiscal year string such as "FY2024@2024-04" and
+// returns the FiscalYear it represents, where "2024-04" is the year and month
+// in which the fiscal year starts. It is the format of MarshalText.
+func ParseFiscalYear[T TimeZone](value string) (FiscalYear[T], error) {
+	if !strings.HasPrefix(value, "FY") {
+		return FiscalYear[T]{}, &iso8601.UnexpectedTokenError{
+			Value:    value,
+			Token:    value,
+			Expected: "FY",
+			Length:   len(value),
+			Kind:     unexpectedKind(value),
+		}
+	}
+	at := strings.IndexByte(value, '@')
+	if at < 0 {
+		at = len(value)
+	}
+	if label := value[2:at]; len(label) != 4 || !isDigits(label) {
+		return FiscalYear[T]{}, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			Token:      label,
+			AfterToken: "FY",
+			Expected:   "4-digit year",
+			Offset:     2,
+			Length:     len(label),
+			Kind:       unexpectedKind(label),
+		}
+	}
+	if at == len(value) {
+		return FiscalYear[T]{}, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			AfterToken: value,
+			Expected:   "'@' and the start of the fiscal year",
+			Offset:     len(value),
+			Kind:       iso8601.KindUnexpectedEnd,
+		}
+	}
+	year, _ := strconv.Atoi(value[2:at])
+	fc, err := parseFiscalStart(value, at, year)
+	if err != nil {
+		return FiscalYear[T]{}, err
+	}
+	return NewFiscalYear[T](fc, year), nil
+}
+
+// FiscalYear returns the fiscal year in which t occurs in the fiscal calendar fc.
+func (t Time[T]) FiscalYear(fc FiscalCalendar) FiscalYear[T] {
+	fy, _ := fc.fiscalYear(t.Year(), t.Month())
+	return NewFiscalYear[T](fc, fy)
+}
+
+// FiscalQuarter returns the fiscal quarter in which t occurs in the fiscal calendar fc.
+// For example, May 2024 is in the 1st quarter of FY2024 if the fiscal year starts in April.
+func (t Time[T]) FiscalQuarter(fc FiscalCalendar) Quarter[T] {
+	fy, index := fc.fiscalYear(t.Year(), t.Month())
+	return NewFiscalQuarter[T](fc, fy, index/3+1)
+}
+
+// FiscalSemester returns the fiscal semester in which t occurs in the fiscal calendar fc.
+func (t Time[T]) FiscalSemester(fc FiscalCalendar) Semester[T] {
+	fy, index := fc.fiscalYear(t.Year(), t.Month())
+	return NewFiscalSemester[T](fc, fy, index/6+1)
+}
+
+// Year returns the label of the fiscal year.
+func (f FiscalYear[T]) Year() int { return f.year }
+
+// Calendar returns the fiscal calendar of f.
+func (f FiscalYear[T]) Calendar() FiscalCalendar { return f.fc }
+
+// Start returns start time in the fiscal year.
+func (f FiscalYear[T]) Start() Time[T] {
+	return NewYearMonth[T](f.fc.yearMonth(f.year, 0)).Start()
+}
+
+// End returns end time in the fiscal year.
+func (f FiscalYear[T]) End() Time[T] {
+	return NewYearMonth[T](f.fc.yearMonth(f.year, 11)).End()
+}
+
+// Quarter returns the n-th quarter of the fiscal year.
+// n may be outside the range [1,4] and will be normalized.
+func (f FiscalYear[T]) Quarter(n int) Quarter[T] {
+	return NewFiscalQuarter[T](f.fc, f.year, n)
+}
+
+// Semester returns the n-th semester of the fiscal year.
+// n may be outside the range [1,2] and will be normalized.
+func (f FiscalYear[T]) Semester(n int) Semester[T] {
+	return NewFiscalSemester[T](f.fc, f.year, n)
+}
+
+// Contains reports whether t occurs in f.
+func (f FiscalYear[T]) Contains(t Time[T]) bool {
+	return t.FiscalYear(f.fc) == f
+}
+
+// Add returns the fiscal year n years after f. n may be negative.
+func (f FiscalYear[T]) Add(n int) FiscalYear[T] {
+	return FiscalYear[T]{year: f.year + n, fc: f.fc}
+}
+
+// Next returns the fiscal year after f.
+func (f FiscalYear[T]) Next() FiscalYear[T] { return f.Add(1) }
+
+// Prev returns the fiscal year before f.
+func (f FiscalYear[T]) Prev() FiscalYear[T] { return f.Add(-1) }
+
+// Sub returns the number of fiscal years f-u.
+//
+// If f and u are in different fiscal calendars, it returns the number of
+// whole years between their start times, truncated toward zero.
+func (f FiscalYear[T]) Sub(u FiscalYear[T]) int { return (f.months() - u.months()) / 12 }
+
+// After reports whether f is after u.
+// Fiscal years in different fiscal calendars are compared by their start times.
+func (f FiscalYear[T]) After(u FiscalYear[T]) bool { return f.months() > u.months() }
+
+// Before reports whether f is before u.
+// Fiscal years in different fiscal calendars are compared by their start times.
+func (f FiscalYear[T]) Before(u FiscalYear[T]) bool { return f.months() < u.months() }
+
+// Compare compares f with u. If f is before u, it returns -1;
+// if f is after u, it returns +1; if they're the same, it returns 0.
+//
+// Fiscal years in different fiscal calendars are compared by their start
+// times, so Compare returns 0 for the fiscal years which start in the same
+// month even though they are different with ==.
+func (f FiscalYear[T]) Compare(u FiscalYear[T]) int {
+	switch m, n := f.months(), u.months(); {
+	case m < n:
+		return -1
+	case m > n:
+		return 1
+	}
+	return 0
+}
+
+// months returns the number of months from January of year 0 to the start of f.
+func (f FiscalYear[T]) months() int {
+	return f.fc.months(f.year, 0)
+}
+
+// String returns the string representation of the format "FYYYYY".
+// For example: "FY2024".
+func (f FiscalYear[T]) String() string {
+	return fmt.Sprintf("FY%04d", f.year)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The fiscal year is formatted in the same way as String, followed by '@'
+// and the year and month in which the fiscal year starts, such as
+// "FY2024@2024-04", so that it can be unmarshaled.
+func (f FiscalYear[T]) MarshalText() ([]byte, error) {
+	startYear, startMonth := f.fc.yearMonth(f.year, 0)
+	return []byte(fmt.Sprintf("FY%04d@%04d-%02d", f.year, startYear, startMonth)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The fiscal year must be in a format supported by ParseFiscalYear.
+func (f *FiscalYear[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseFiscalYear[T](string(data))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The fiscal year is a quoted string in the same format as MarshalText.
+func (f FiscalYear[T]) MarshalJSON() ([]byte, error) {
+	b, _ := f.MarshalText()
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The fiscal year must be a quoted string in a format supported by ParseFiscalYear.
+func (f *FiscalYear[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return f.UnmarshalText([]byte(s))
+}
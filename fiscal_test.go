@@ -0,0 +1,281 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_FiscalQuarter() {
+	fc := synchro.FiscalCalendar{StartMonth: time.April}
+	q := synchro.New[tz.AsiaTokyo](2025, 2, 10, 0, 0, 0, 0).FiscalQuarter(fc)
+	fmt.Println(q)
+	fmt.Println(q.Start())
+	fmt.Println(q.End())
+	// Output:
+	// FY2024-Q4
+	// 2025-01-01 00:00:00 +0900 JST
+	// 2025-03-31 23:59:59.999999999 +0900 JST
+}
+
+func TestFiscalCalendar(t *testing.T) {
+	jp := synchro.FiscalCalendar{StartMonth: time.April}
+	us := synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}
+	tests := []struct {
+		name         string
+		fc           synchro.FiscalCalendar
+		t            synchro.Time[tz.UTC]
+		wantYear     string
+		wantQuarter  string
+		wantSemester string
+		wantStart    synchro.Time[tz.UTC]
+	}{
+		{
+			name:         "calendar year",
+			fc:           synchro.FiscalCalendar{},
+			t:            synchro.New[tz.UTC](2024, 5, 10, 0, 0, 0, 0),
+			wantYear:     "FY2024",
+			wantQuarter:  "2024-Q2",
+			wantSemester: "2024-H1",
+			wantStart:    synchro.New[tz.UTC](2024, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			name:         "January with end naming is calendar year",
+			fc:           synchro.FiscalCalendar{StartMonth: time.January, Naming: synchro.FiscalYearNamedByEnd},
+			t:            synchro.New[tz.UTC](2024, 5, 10, 0, 0, 0, 0),
+			wantYear:     "FY2024",
+			wantQuarter:  "2024-Q2",
+			wantSemester: "2024-H1",
+			wantStart:    synchro.New[tz.UTC](2024, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			name:         "April start, first month",
+			fc:           jp,
+			t:            synchro.New[tz.UTC](2024, 4, 1, 0, 0, 0, 0),
+			wantYear:     "FY2024",
+			wantQuarter:  "FY2024-Q1",
+			wantSemester: "FY2024-H1",
+			wantStart:    synchro.New[tz.UTC](2024, 4, 1, 0, 0, 0, 0),
+		},
+		{
+			name:         "April start, next calendar year",
+			fc:           jp,
+			t:            synchro.New[tz.UTC](2025, 3, 31, 23, 59, 59, 0),
+			wantYear:     "FY2024",
+			wantQuarter:  "FY2024-Q4",
+			wantSemester: "FY2024-H2",
+			wantStart:    synchro.New[tz.UTC](2024, 4, 1, 0, 0, 0, 0),
+		},
+		{
+			name:         "October start named by end",
+			fc:           us,
+			t:            synchro.New[tz.UTC](2024, 10, 1, 0, 0, 0, 0),
+			wantYear:     "FY2025",
+			wantQuarter:  "FY2025-Q1",
+			wantSemester: "FY2025-H1",
+			wantStart:    synchro.New[tz.UTC](2024, 10, 1, 0, 0, 0, 0),
+		},
+		{
+			name:         "October start named by end, before start",
+			fc:           us,
+			t:            synchro.New[tz.UTC](2024, 9, 30, 0, 0, 0, 0),
+			wantYear:     "FY2024",
+			wantQuarter:  "FY2024-Q4",
+			wantSemester: "FY2024-H2",
+			wantStart:    synchro.New[tz.UTC](2023, 10, 1, 0, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fy := tt.t.FiscalYear(tt.fc)
+			if got := fy.String(); got != tt.wantYear {
+				t.Errorf("want year %s but got %s", tt.wantYear, got)
+			}
+			if !tt.wantStart.Equal(fy.Start()) {
+				t.Errorf("want start %v but got %v", tt.wantStart, fy.Start())
+			}
+			if want := tt.wantStart.AddDate(1, 0, 0).Add(-time.Nanosecond); !want.Equal(fy.End()) {
+				t.Errorf("want end %v but got %v", want, fy.End())
+			}
+			if !fy.Contains(tt.t) {
+				t.Errorf("want %v in %s", tt.t, fy)
+			}
+			q := tt.t.FiscalQuarter(tt.fc)
+			if got := q.String(); got != tt.wantQuarter {
+				t.Errorf("want quarter %s but got %s", tt.wantQuarter, got)
+			}
+			if !q.Contains(tt.t) || !q.Contains(q.Start()) || !q.Contains(q.End()) {
+				t.Errorf("want %v in %s [%v, %v]", tt.t, q, q.Start(), q.End())
+			}
+			if q.FiscalYear() != fy {
+				t.Errorf("want %s but got %s", fy, q.FiscalYear())
+			}
+			s := tt.t.FiscalSemester(tt.fc)
+			if got := s.String(); got != tt.wantSemester {
+				t.Errorf("want semester %s but got %s", tt.wantSemester, got)
+			}
+			if !s.Contains(tt.t) || !s.Contains(s.Start()) || !s.Contains(s.End()) {
+				t.Errorf("want %v in %s [%v, %v]", tt.t, s, s.Start(), s.End())
+			}
+		})
+	}
+}
+
+func TestFiscalYear_Quarters(t *testing.T) {
+	fy := synchro.NewFiscalYear[tz.UTC](synchro.FiscalCalendar{StartMonth: time.April}, 2024)
+	q := fy.Quarter(1)
+	for i := 0; i < 4; i++ {
+		if !q.Start().Equal(fy.Quarter(i + 1).Start()) {
+			t.Errorf("want %v but got %v", fy.Quarter(i+1).Start(), q.Start())
+		}
+		next := q.Next()
+		if !next.Start().Equal(q.End().Add(time.Nanosecond)) {
+			t.Errorf("%s and %s are not contiguous", q, next)
+		}
+		q = next
+	}
+	if want := "FY2025-Q1"; q.String() != want {
+		t.Errorf("want %s but got %s", want, q)
+	}
+	if got := fy.Quarter(0).String(); got != "FY2023-Q4" {
+		t.Errorf("want FY2023-Q4 but got %s", got)
+	}
+	if got := fy.Semester(2).End(); !got.Equal(fy.End()) {
+		t.Errorf("want %v but got %v", fy.End(), got)
+	}
+	if fy.Next().Sub(fy) != 1 || !fy.Prev().Before(fy) || fy.Compare(fy.Next()) != -1 {
+		t.Errorf("unexpected fiscal year arithmetic")
+	}
+	if synchro.NewQuarter[tz.UTC](2024, 1) == synchro.NewFiscalQuarter[tz.UTC](synchro.FiscalCalendar{StartMonth: time.April}, 2024, 1) {
+		t.Errorf("calendar and fiscal quarters must be different")
+	}
+}
+
+func TestFiscalPeriod_Compare(t *testing.T) {
+	jp := synchro.FiscalCalendar{StartMonth: time.April}
+	calendar := synchro.NewQuarter[tz.UTC](2024, 1)         // 2024-01 to 2024-03
+	fiscal := synchro.NewFiscalQuarter[tz.UTC](jp, 2024, 1) // 2024-04 to 2024-06
+	if calendar.Compare(fiscal) != -1 || !calendar.Before(fiscal) || calendar.After(fiscal) {
+		t.Errorf("want %s before %s", calendar, fiscal)
+	}
+	if got := fiscal.Sub(calendar); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	// The quarters which start in the same month are the same in Compare.
+	if got := synchro.NewQuarter[tz.UTC](2024, 2).Compare(fiscal); got != 0 {
+		t.Errorf("want 0 but got %d", got)
+	}
+	// The quarters which start in different months are compared by the start.
+	feb := synchro.NewFiscalQuarter[tz.UTC](synchro.FiscalCalendar{StartMonth: time.February}, 2024, 1) // 2024-02 to 2024-04
+	if feb.Compare(calendar) != 1 || fiscal.Compare(feb) != 1 || fiscal.Sub(feb) != 0 {
+		t.Errorf("unexpected comparison of %s", feb)
+	}
+
+	// JP FY2024 starts in 2024-04 and US FY2024 starts in 2023-10.
+	us := synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}
+	jpFY, usFY := synchro.NewFiscalYear[tz.UTC](jp, 2024), synchro.NewFiscalYear[tz.UTC](us, 2024)
+	if jpFY.Compare(usFY) != 1 || !jpFY.After(usFY) || jpFY.Before(usFY) || jpFY.Sub(usFY) != 0 {
+		t.Errorf("want %s after %s", jpFY, usFY)
+	}
+	if got := synchro.NewFiscalYear[tz.UTC](us, 2026).Sub(jpFY); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := synchro.NewFiscalYear[tz.UTC](synchro.FiscalCalendar{}, 2024).Compare(synchro.NewFiscalYear[tz.UTC](us, 2024)); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+
+	h1 := synchro.NewSemester[tz.UTC](2024, 1)
+	fh1 := synchro.NewFiscalSemester[tz.UTC](jp, 2024, 1)
+	if h1.Compare(fh1) != -1 || !h1.Before(fh1) || h1.After(fh1) || fh1.Sub(h1) != 0 {
+		t.Errorf("want %s before %s", h1, fh1)
+	}
+	if got := synchro.NewFiscalSemester[tz.UTC](jp, 2024, 2).Sub(h1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+}
+
+func TestFiscalPeriod_JSON(t *testing.T) {
+	type period struct {
+		Year     synchro.FiscalYear[tz.UTC]
+		Quarter  synchro.Quarter[tz.UTC]
+		Semester synchro.Semester[tz.UTC]
+	}
+	us := synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}
+	tests := []struct {
+		fc   synchro.FiscalCalendar
+		want string
+	}{
+		{
+			fc:   synchro.FiscalCalendar{},
+			want: `{"Year":"FY2025@2025-01","Quarter":"2025-Q1","Semester":"2025-H2"}`,
+		},
+		{
+			fc:   synchro.FiscalCalendar{StartMonth: time.April},
+			want: `{"Year":"FY2025@2025-04","Quarter":"FY2025-Q1@2025-04","Semester":"FY2025-H2@2025-04"}`,
+		},
+		{
+			fc:   us,
+			want: `{"Year":"FY2025@2024-10","Quarter":"FY2025-Q1@2024-10","Semester":"FY2025-H2@2024-10"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			p := period{
+				Year:     synchro.NewFiscalYear[tz.UTC](tt.fc, 2025),
+				Quarter:  synchro.NewFiscalQuarter[tz.UTC](tt.fc, 2025, 1),
+				Semester: synchro.NewFiscalSemester[tz.UTC](tt.fc, 2025, 2),
+			}
+			b, err := json.Marshal(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("want %s but got %s", tt.want, b)
+			}
+			var got period
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != p {
+				t.Errorf("want %v but got %v", p, got)
+			}
+		})
+	}
+}
+
+func TestParseFiscalYear(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.FiscalYear[tz.UTC]
+		wantErr bool
+	}{
+		{value: "FY2024@2024-04", want: synchro.NewFiscalYear[tz.UTC](synchro.FiscalCalendar{StartMonth: time.April}, 2024)},
+		{value: "FY2025@2024-10", want: synchro.NewFiscalYear[tz.UTC](synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}, 2025)},
+		{value: "FY2024@2024-01", want: synchro.NewFiscalYear[tz.UTC](synchro.FiscalCalendar{}, 2024)},
+		{value: "FY2024", wantErr: true},
+		{value: "2024@2024-04", wantErr: true},
+		{value: "FY24@2024-04", wantErr: true},
+		{value: "FY2024@2024-13", wantErr: true},
+		{value: "FY2024@2022-04", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := synchro.ParseFiscalYear[tz.UTC](tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: want error but got %s", tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: want %s but got %s", tt.value, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,417 @@
+package synchro
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// HolidayRules is a HolidayCalendar which is defined by rules in a small
+// declarative format. Use ParseHolidayRules to create it.
+//
+// Each non-empty line of the format defines a holiday as "Name: date[, option...]".
+// Lines beginning with '#' are comments. The date is one of:
+//
+//	December 25              a fixed date
+//	4th Thursday of November the n-th (1st to 5th) weekday of the month
+//	last Monday of May       the last weekday of the month
+//	easter, easter-2         Easter Sunday in the Gregorian calendar, with an offset in days
+//	2022-09-19               a one-off date
+//
+// The options are:
+//
+//	observed nearest weekday  If the date is on Saturday, Friday is also a holiday.
+//	                          If it is on Sunday, Monday is also a holiday.
+//	observed next weekday     If the date is on a weekend, the next weekday which is
+//	                          not a holiday is also a holiday.
+//	from 1971                 The rule is effective from the year.
+//	until 2019                The rule is effective until the year (inclusive).
+//	except 1995 2020          The rule is not effective in the years.
+//
+// The observed days are named with the suffix " (observed)", and Saturday and
+// Sunday are the weekend for the observance.
+//
+// For example, USFederalHolidays and EnglandAndWalesBankHolidays are written
+// in this format.
+type HolidayRules[T TimeZone] struct {
+	rules []holidayRule
+	cache sync.Map // map[int][]Holiday[T]
+}
+
+var _ HolidayCalendar[tz.UTC] = (*HolidayRules[tz.UTC])(nil)
+
+// USFederalHolidays is the definition of the federal holidays in the United States
+// by 5 U.S.C. 6103 since the Uniform Monday Holiday Act took effect in 1971.
+const USFederalHolidays = `
+New Year's Day: January 1, observed nearest weekday
+Birthday of Martin Luther King, Jr.: 3rd Monday of January, from 1986
+Washington's Birthday: 3rd Monday of February, from 1971
+Memorial Day: last Monday of May, from 1971
+Juneteenth National Independence Day: June 19, observed nearest weekday, from 2021
+Independence Day: July 4, observed nearest weekday
+Labor Day: 1st Monday of September
+Columbus Day: 2nd Monday of October, from 1971
+Veterans Day: 4th Monday of October, from 1971, until 1977
+Veterans Day: November 11, observed nearest weekday, except 1971 1972 1973 1974 1975 1976 1977
+Thanksgiving Day: 4th Thursday of November, from 1942
+Christmas Day: December 25, observed nearest weekday
+`
+
+// EnglandAndWalesBankHolidays is the definition of the bank holidays in England and Wales,
+// including the one-off bank holidays since 1971.
+const EnglandAndWalesBankHolidays = `
+New Year's Day: January 1, observed next weekday, from 1974
+Good Friday: easter-2
+Easter Monday: easter+1
+Early May bank holiday: 1st Monday of May, from 1978, except 1995 2020
+Early May bank holiday (VE day): 1995-05-08
+Early May bank holiday (VE day): 2020-05-08
+Spring bank holiday: last Monday of May, from 1971, except 2002 2012 2022
+Spring bank holiday: 2002-06-04
+Spring bank holiday: 2012-06-04
+Spring bank holiday: 2022-06-02
+Summer bank holiday: last Monday of August, from 1971
+Christmas Day: December 25, observed next weekday
+Boxing Day: December 26, observed next weekday
+
+# One-off bank holidays.
+Wedding of Prince Charles and Lady Diana Spencer: 1981-07-29
+Millennium Celebrations: 1999-12-31
+Golden Jubilee of Queen Elizabeth II: 2002-06-03
+Wedding of Prince William and Catherine Middleton: 2011-04-29
+Diamond Jubilee of Queen Elizabeth II: 2012-06-05
+Platinum Jubilee of Queen Elizabeth II: 2022-06-03
+State Funeral of Queen Elizabeth II: 2022-09-19
+Coronation of King Charles III: 2023-05-08
+`
+
+type observance int
+
+const (
+	observeNone observance = iota
+	observeNearestWeekday
+	observeNextWeekday
+)
+
+type holidayRule struct {
+	name        string
+	date        func(year int) (Date[tz.UTC], bool)
+	observed    observance
+	from, until int
+	except      []int
+}
+
+func (r holidayRule) effective(year int) bool {
+	if (r.from != 0 && year < r.from) || (r.until != 0 && year > r.until) {
+		return false
+	}
+	for _, e := range r.except {
+		if e == year {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseHolidayRules parses the holiday rules in the format described in HolidayRules.
+func ParseHolidayRules[T TimeZone](spec string) (*HolidayRules[T], error) {
+	var rules []holidayRule
+	for i, line := range strings.Split(spec, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r, err := parseHolidayRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		rules = append(rules, r)
+	}
+	return &HolidayRules[T]{rules: rules}, nil
+}
+
+// MustParseHolidayRules is like ParseHolidayRules but panics if the rules
+// cannot be parsed. It is intended for the built-in definitions, such as
+// USFederalHolidays.
+func MustParseHolidayRules[T TimeZone](spec string) *HolidayRules[T] {
+	r, err := ParseHolidayRules[T](spec)
+	if err != nil {
+		panic(`synchro: ParseHolidayRules: ` + err.Error())
+	}
+	return r
+}
+
+func parseHolidayRule(line string) (holidayRule, error) {
+	i := strings.LastIndex(line, ":")
+	if i < 0 {
+		return holidayRule{}, fmt.Errorf("missing ':' in %q", line)
+	}
+	name := strings.TrimSpace(line[:i])
+	if name == "" {
+		return holidayRule{}, fmt.Errorf("missing holiday name in %q", line)
+	}
+	parts := strings.Split(line[i+1:], ",")
+	r := holidayRule{name: name}
+	date, err := parseHolidayDate(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return holidayRule{}, err
+	}
+	r.date = date
+	for _, opt := range parts[1:] {
+		fields := strings.Fields(strings.ToLower(opt))
+		if len(fields) == 0 {
+			return holidayRule{}, fmt.Errorf("empty option in %q", line)
+		}
+		switch fields[0] {
+		case "observed":
+			switch strings.Join(fields[1:], " ") {
+			case "nearest weekday":
+				r.observed = observeNearestWeekday
+			case "next weekday":
+				r.observed = observeNextWeekday
+			default:
+				return holidayRule{}, fmt.Errorf("unknown observance %q", strings.TrimSpace(opt))
+			}
+		case "from", "until", "except":
+			if len(fields) < 2 || (fields[0] != "except" && len(fields) > 2) {
+				return holidayRule{}, fmt.Errorf("invalid option %q", strings.TrimSpace(opt))
+			}
+			years := make([]int, 0, len(fields)-1)
+			for _, f := range fields[1:] {
+				year, err := strconv.Atoi(f)
+				if err != nil {
+					return holidayRule{}, fmt.Errorf("invalid year %q in option %q", f, strings.TrimSpace(opt))
+				}
+				years = append(years, year)
+			}
+			switch fields[0] {
+			case "from":
+				r.from = years[0]
+			case "until":
+				r.until = years[0]
+			default:
+				r.except = append(r.except, years...)
+			}
+		default:
+			return holidayRule{}, fmt.Errorf("unknown option %q", strings.TrimSpace(opt))
+		}
+	}
+	return r, nil
+}
+
+var nthWords = map[string]int{"1st": 1, "2nd": 2, "3rd": 3, "4th": 4, "5th": 5, "last": -1}
+
+func parseHolidayDate(s string) (func(year int) (Date[tz.UTC], bool), error) {
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "easter") {
+		offset := 0
+		if rest := strings.ReplaceAll(lower[len("easter"):], " ", ""); rest != "" {
+			n, err := strconv.Atoi(rest)
+			if err != nil || (rest[0] != '+' && rest[0] != '-') {
+				return nil, fmt.Errorf("invalid offset of easter in %q", s)
+			}
+			offset = n
+		}
+		return func(year int) (Date[tz.UTC], bool) {
+			return easter(year).AddDays(offset), true
+		}, nil
+	}
+	if tm, err := time.Parse(time.DateOnly, s); err == nil {
+		once := NewDate[tz.UTC](tm.Date())
+		return func(year int) (Date[tz.UTC], bool) {
+			return once, year == once.Year()
+		}, nil
+	}
+	fields := strings.Fields(lower)
+	switch {
+	case len(fields) == 2:
+		month, ok := parseMonthName(fields[0])
+		if !ok {
+			return nil, fmt.Errorf("unknown month %q in %q", fields[0], s)
+		}
+		day, err := strconv.Atoi(fields[1])
+		if err != nil || day < 1 || day > daysIn(2000, month) { // 2000 is a leap year.
+			return nil, fmt.Errorf("invalid day %q in %q", fields[1], s)
+		}
+		return func(year int) (Date[tz.UTC], bool) {
+			// February 29 is a holiday only in leap years.
+			return NewDate[tz.UTC](year, month, day), day <= daysIn(year, month)
+		}, nil
+	case len(fields) == 4 && fields[2] == "of":
+		n, ok := nthWords[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown ordinal %q in %q", fields[0], s)
+		}
+		weekday, ok := parseWeekdayName(fields[1])
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q in %q", fields[1], s)
+		}
+		month, ok := parseMonthName(fields[3])
+		if !ok {
+			return nil, fmt.Errorf("unknown month %q in %q", fields[3], s)
+		}
+		return func(year int) (Date[tz.UTC], bool) {
+			return nthWeekdayOf(year, month, weekday, n)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown date %q", s)
+}
+
+func parseMonthName(s string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if name := strings.ToLower(m.String()); s == name || s == name[:3] {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+func parseWeekdayName(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if name := strings.ToLower(d.String()); s == name || s == name[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// nthWeekdayOf returns the n-th weekday of the month. If n is negative,
+// it counts from the end of the month. The boolean is false if the month
+// does not have the n-th weekday.
+func nthWeekdayOf(year int, month time.Month, weekday time.Weekday, n int) (Date[tz.UTC], bool) {
+	if n < 0 {
+		last := NewDate[tz.UTC](year, month+1, 0)
+		d := last.AddDays(-((int(last.Weekday())-int(weekday)+7)%7 + (-n-1)*7))
+		return d, d.Month() == month
+	}
+	first := NewDate[tz.UTC](year, month, 1)
+	d := first.AddDays((int(weekday)-int(first.Weekday())+7)%7 + (n-1)*7)
+	return d, d.Month() == month
+}
+
+// easter returns the date of Easter Sunday in the Gregorian calendar by the
+// anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func easter(year int) Date[tz.UTC] {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return NewDate[tz.UTC](year, time.Month(month), day)
+}
+
+// IsHoliday reports whether d is a holiday by the rules.
+func (r *HolidayRules[T]) IsHoliday(d Date[T]) bool {
+	_, ok := r.Lookup(d)
+	return ok
+}
+
+// Lookup returns the holiday on d. The boolean is false if d is not a holiday.
+// If more than one holiday is on d, the first one in the rules is returned.
+func (r *HolidayRules[T]) Lookup(d Date[T]) (Holiday[T], bool) {
+	for _, h := range r.inYear(d.Year()) {
+		if h.Date == d {
+			return h, true
+		}
+	}
+	return Holiday[T]{}, false
+}
+
+// InYear returns the holidays in the year in chronological order, including
+// the observed days. The observed days of the holidays in the adjacent years
+// are also included if they fall in the year.
+func (r *HolidayRules[T]) InYear(year int) []Holiday[T] {
+	return append([]Holiday[T](nil), r.inYear(year)...)
+}
+
+func (r *HolidayRules[T]) inYear(year int) []Holiday[T] {
+	if v, ok := r.cache.Load(year); ok {
+		return v.([]Holiday[T])
+	}
+	holidays := r.expand(year)
+	r.cache.Store(year, holidays)
+	return holidays
+}
+
+type expandedHoliday struct {
+	date     Date[tz.UTC]
+	name     string
+	observed observance
+	order    int
+}
+
+func (r *HolidayRules[T]) expand(year int) []Holiday[T] {
+	// The observed days may cross the year, so the adjacent years are expanded.
+	var actual []expandedHoliday
+	occupied := make(map[Date[tz.UTC]]bool)
+	for y := year - 1; y <= year+1; y++ {
+		for i, rule := range r.rules {
+			if !rule.effective(y) {
+				continue
+			}
+			d, ok := rule.date(y)
+			if !ok {
+				continue
+			}
+			actual = append(actual, expandedHoliday{date: d, name: rule.name, observed: rule.observed, order: i})
+			occupied[d] = true
+		}
+	}
+	sortExpandedHolidays(actual)
+
+	all := actual
+	for _, h := range actual {
+		if !isSaturdayOrSunday(h.date) || h.observed == observeNone {
+			continue
+		}
+		var d Date[tz.UTC]
+		switch h.observed {
+		case observeNearestWeekday:
+			d = h.date.AddDays(1)
+			if h.date.Weekday() == time.Saturday {
+				d = h.date.AddDays(-1)
+			}
+		case observeNextWeekday:
+			d = h.date.AddDays(1)
+			for isSaturdayOrSunday(d) || occupied[d] {
+				d = d.AddDays(1)
+			}
+			occupied[d] = true
+		}
+		all = append(all, expandedHoliday{date: d, name: h.name + " (observed)", order: h.order})
+	}
+	sortExpandedHolidays(all)
+
+	result := make([]Holiday[T], 0, len(all))
+	for _, h := range all {
+		if h.date.Year() == year {
+			result = append(result, Holiday[T]{Date: Date[T]{tm: h.date.tm}, Name: h.name})
+		}
+	}
+	return result
+}
+
+func sortExpandedHolidays(hs []expandedHoliday) {
+	sort.SliceStable(hs, func(i, j int) bool {
+		if c := hs[i].date.Compare(hs[j].date); c != 0 {
+			return c < 0
+		}
+		return hs[i].order < hs[j].order
+	})
+}
+
+func isSaturdayOrSunday(d Date[tz.UTC]) bool {
+	w := d.Weekday()
+	return w == time.Saturday || w == time.Sunday
+}
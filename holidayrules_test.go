@@ -0,0 +1,266 @@
+package synchro_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleParseHolidayRules() {
+	rules, err := synchro.ParseHolidayRules[tz.UTC](`
+# Company holidays.
+Founders' Day: 2nd Friday of March, from 2010
+Summer Break: last Friday of August
+Christmas Day: December 25, observed nearest weekday
+`)
+	if err != nil {
+		panic(err)
+	}
+	for _, h := range rules.InYear(2022) {
+		fmt.Println(h)
+	}
+	// Output:
+	// 2022-03-11 Founders' Day
+	// 2022-08-26 Summer Break
+	// 2022-12-25 Christmas Day
+	// 2022-12-26 Christmas Day (observed)
+}
+
+func TestHolidayRules_InYear(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		year int
+		want []string
+	}{
+		{
+			name: "US federal holidays",
+			spec: synchro.USFederalHolidays,
+			year: 2021,
+			want: []string{
+				"2021-01-01 New Year's Day",
+				"2021-01-18 Birthday of Martin Luther King, Jr.",
+				"2021-02-15 Washington's Birthday",
+				"2021-05-31 Memorial Day",
+				"2021-06-18 Juneteenth National Independence Day (observed)",
+				"2021-06-19 Juneteenth National Independence Day",
+				"2021-07-04 Independence Day",
+				"2021-07-05 Independence Day (observed)",
+				"2021-09-06 Labor Day",
+				"2021-10-11 Columbus Day",
+				"2021-11-11 Veterans Day",
+				"2021-11-25 Thanksgiving Day",
+				"2021-12-24 Christmas Day (observed)",
+				"2021-12-25 Christmas Day",
+				"2021-12-31 New Year's Day (observed)",
+			},
+		},
+		{
+			name: "US federal holidays in 1975",
+			spec: synchro.USFederalHolidays,
+			year: 1975,
+			want: []string{
+				"1975-01-01 New Year's Day",
+				"1975-02-17 Washington's Birthday",
+				"1975-05-26 Memorial Day",
+				"1975-07-04 Independence Day",
+				"1975-09-01 Labor Day",
+				"1975-10-13 Columbus Day",
+				"1975-10-27 Veterans Day",
+				"1975-11-27 Thanksgiving Day",
+				"1975-12-25 Christmas Day",
+			},
+		},
+		{
+			name: "England and Wales bank holidays",
+			spec: synchro.EnglandAndWalesBankHolidays,
+			year: 2022,
+			want: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-03 New Year's Day (observed)",
+				"2022-04-15 Good Friday",
+				"2022-04-18 Easter Monday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee of Queen Elizabeth II",
+				"2022-08-29 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (observed)",
+			},
+		},
+		{
+			name: "England and Wales bank holidays with cascading substitutes",
+			spec: synchro.EnglandAndWalesBankHolidays,
+			year: 2021,
+			want: []string{
+				"2021-01-01 New Year's Day",
+				"2021-04-02 Good Friday",
+				"2021-04-05 Easter Monday",
+				"2021-05-03 Early May bank holiday",
+				"2021-05-31 Spring bank holiday",
+				"2021-08-30 Summer bank holiday",
+				"2021-12-25 Christmas Day",
+				"2021-12-26 Boxing Day",
+				"2021-12-27 Christmas Day (observed)",
+				"2021-12-28 Boxing Day (observed)",
+			},
+		},
+		{
+			name: "England and Wales bank holidays with VE day",
+			spec: synchro.EnglandAndWalesBankHolidays,
+			year: 2020,
+			want: []string{
+				"2020-01-01 New Year's Day",
+				"2020-04-10 Good Friday",
+				"2020-04-13 Easter Monday",
+				"2020-05-08 Early May bank holiday (VE day)",
+				"2020-05-25 Spring bank holiday",
+				"2020-08-31 Summer bank holiday",
+				"2020-12-25 Christmas Day",
+				"2020-12-26 Boxing Day",
+				"2020-12-28 Boxing Day (observed)",
+			},
+		},
+		{
+			name: "leap day and 5th weekday",
+			spec: "Leap Day: February 29\nFifth Sunday: 5th Sunday of March",
+			year: 2023,
+			want: []string{},
+		},
+		{
+			name: "leap day and 5th weekday",
+			spec: "Leap Day: Feb 29\nFifth Sunday: 5th Sun of March",
+			year: 2020,
+			want: []string{
+				"2020-02-29 Leap Day",
+				"2020-03-29 Fifth Sunday",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %d", tt.name, tt.year), func(t *testing.T) {
+			rules, err := synchro.ParseHolidayRules[tz.UTC](tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := rules.InYear(tt.year)
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %d holidays but got %d: %v", len(tt.want), len(got), got)
+			}
+			for i := range tt.want {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("[%d] want %q but got %q", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHolidayRules_Easter(t *testing.T) {
+	rules := synchro.MustParseHolidayRules[tz.UTC]("Easter Sunday: easter")
+	for _, want := range []string{
+		"1818-03-22",
+		"1943-04-25",
+		"2000-04-23",
+		"2008-03-23",
+		"2024-03-31",
+		"2025-04-20",
+		"2038-04-25",
+	} {
+		d, err := synchro.ParseDate[tz.UTC](want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := rules.InYear(d.Year())
+		if len(got) != 1 || got[0].Date != d {
+			t.Errorf("want %s but got %v", want, got)
+		}
+	}
+}
+
+func TestHolidayRules_Lookup(t *testing.T) {
+	rules := synchro.MustParseHolidayRules[tz.AmericaNew_York](synchro.USFederalHolidays)
+	tests := []struct {
+		date synchro.Date[tz.AmericaNew_York]
+		want string
+	}{
+		{date: synchro.NewDate[tz.AmericaNew_York](2023, 11, 23), want: "Thanksgiving Day"},
+		{date: synchro.NewDate[tz.AmericaNew_York](2023, 11, 10), want: "Veterans Day (observed)"},
+		{date: synchro.NewDate[tz.AmericaNew_York](2020, 6, 19), want: ""}, // before 2021
+		{date: synchro.NewDate[tz.AmericaNew_York](1985, 1, 21), want: ""}, // before 1986
+		{date: synchro.NewDate[tz.AmericaNew_York](1986, 1, 20), want: "Birthday of Martin Luther King, Jr."},
+		{date: synchro.NewDate[tz.AmericaNew_York](2016, 12, 26), want: "Christmas Day (observed)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			h, ok := rules.Lookup(tt.date)
+			if ok != (tt.want != "") || h.Name != tt.want {
+				t.Errorf("want %q but got %q (%v)", tt.want, h.Name, ok)
+			}
+			if rules.IsHoliday(tt.date) != ok {
+				t.Errorf("IsHoliday must be consistent with Lookup")
+			}
+		})
+	}
+}
+
+func TestParseHolidayRules_Error(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{spec: "Christmas Day", want: `line 1: missing ':' in "Christmas Day"`},
+		{spec: ": December 25", want: `line 1: missing holiday name in ": December 25"`},
+		{spec: "\n\nX: Decembre 25", want: `line 3: unknown month "decembre" in "Decembre 25"`},
+		{spec: "X: February 30", want: `line 1: invalid day "30" in "February 30"`},
+		{spec: "X: 6th Monday of May", want: `line 1: unknown ordinal "6th" in "6th Monday of May"`},
+		{spec: "X: last Funday of May", want: `line 1: unknown weekday "funday" in "last Funday of May"`},
+		{spec: "X: easter 2", want: `line 1: invalid offset of easter in "easter 2"`},
+		{spec: "X: tomorrow", want: `line 1: unknown date "tomorrow"`},
+		{spec: "X: May 1, observed previous weekday", want: `line 1: unknown observance "observed previous weekday"`},
+		{spec: "X: May 1, from 19xx", want: `line 1: invalid year "19xx" in option "from 19xx"`},
+		{spec: "X: May 1, until 2000 2001", want: `line 1: invalid option "until 2000 2001"`},
+		{spec: "X: May 1, sometimes", want: `line 1: unknown option "sometimes"`},
+		{spec: "X: May 1,", want: `line 1: empty option in "X: May 1,"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := synchro.ParseHolidayRules[tz.UTC](tt.spec)
+			if err == nil {
+				t.Fatal("want error")
+			}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMustParseHolidayRules(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil || !strings.HasPrefix(fmt.Sprint(r), "synchro: ParseHolidayRules: ") {
+			t.Errorf("want panic but got %v", r)
+		}
+	}()
+	synchro.MustParseHolidayRules[tz.UTC]("invalid")
+}
+
+func TestHolidayRules_BusinessCalendar(t *testing.T) {
+	c := synchro.NewBusinessCalendar[tz.EuropeLondon](
+		synchro.WeekendSaturdaySunday,
+		synchro.MustParseHolidayRules[tz.EuropeLondon](synchro.EnglandAndWalesBankHolidays),
+	)
+	got, err := synchro.New[tz.EuropeLondon](2021, 12, 24, 9, 0, 0, 0).NextBusinessDay(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.New[tz.EuropeLondon](2021, 12, 29, 9, 0, 0, 0); !want.Equal(got) {
+		t.Errorf("want %v but got %v", want, got)
+	}
+}
@@ -0,0 +1,187 @@
+package synchro
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// HumanizeThresholds are the thresholds to choose the unit of relative times.
+// Each threshold except JustNow is the number of the unit from which the next
+// larger unit is used. For example, if Minutes is 45, 44 minutes is described
+// as "44 minutes" and 45 minutes is described as "1 hour".
+type HumanizeThresholds struct {
+	// JustNow is the duration below which the time is described as "just now".
+	JustNow time.Duration
+	Seconds int
+	Minutes int
+	Hours   int
+	Days    int
+	// Weeks is the threshold of weeks. If it is zero, weeks are not used and
+	// Days is the threshold of days to months.
+	Weeks  int
+	Months int
+}
+
+// DefaultHumanizeThresholds is the default thresholds of Humanize.
+var DefaultHumanizeThresholds = HumanizeThresholds{
+	JustNow: 10 * time.Second,
+	Seconds: 45,
+	Minutes: 45,
+	Hours:   22,
+	Days:    26,
+	Months:  11,
+}
+
+type humanizeOptions struct {
+	locale     *Locale
+	thresholds HumanizeThresholds
+	rounding   func(float64) float64
+}
+
+// HumanizeOptions is a function type that modifies the behavior of Humanize.
+// It acts as a functional option.
+type HumanizeOptions func(*humanizeOptions)
+
+// WithLocale is an option to describe relative times in the locale.
+//
+// By default, EnglishLocale is used.
+func WithLocale(l *Locale) HumanizeOptions {
+	return func(o *humanizeOptions) {
+		o.locale = l
+	}
+}
+
+// WithHumanizeThresholds is an option to change the thresholds to choose
+// the unit of relative times.
+//
+// By default, DefaultHumanizeThresholds is used.
+func WithHumanizeThresholds(th HumanizeThresholds) HumanizeOptions {
+	return func(o *humanizeOptions) {
+		o.thresholds = th
+	}
+}
+
+// WithRounding is an option to change the rounding of the number of units.
+// For example, math.Floor describes 1 hour and 50 minutes as "1 hour".
+//
+// By default, math.Round is used.
+func WithRounding(round func(float64) float64) HumanizeOptions {
+	return func(o *humanizeOptions) {
+		o.rounding = round
+	}
+}
+
+const humanizeWeek = 7 * 24 * time.Hour
+
+// Humanize returns the description of t relative to ref, such as "3 hours ago"
+// or "in 2 days". If ref is the zero value, the current time is used.
+//
+// Months and years are counted on the calendar in the timezone T, with the
+// remainder as a fraction of the following month, so times which are more
+// than 292 years apart are also described correctly.
+func (t Time[T]) Humanize(ref Time[T], opts ...HumanizeOptions) string {
+	if ref.IsZero() {
+		ref = Now[T]()
+	}
+	return humanize(t.StdTime(), ref.StdTime(), opts)
+}
+
+// HumanizeContext is like Humanize but uses the current time stored in ctx by
+// NowWithContext as the reference time. If the time is not found in ctx,
+// the current time is used.
+func (t Time[T]) HumanizeContext(ctx context.Context, opts ...HumanizeOptions) string {
+	return t.Humanize(NowContext[T](ctx), opts...)
+}
+
+func humanize(t, ref time.Time, opts []HumanizeOptions) string {
+	o := &humanizeOptions{
+		locale:     EnglishLocale,
+		thresholds: DefaultHumanizeThresholds,
+		rounding:   math.Round,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	english := EnglishLocale.RelativeTime
+	names := o.locale.RelativeTime.withFallback(english)
+
+	from, to := ref, t
+	past := t.Before(ref)
+	if past {
+		from, to = t, ref
+	}
+	if to.Sub(from) < o.thresholds.JustNow {
+		return names.JustNow
+	}
+	unit, n := humanizeUnit(from, to, o.thresholds, o.rounding)
+	s := fmt.Sprintf(names.unit(unit, english).name(n), n)
+	if past {
+		return fmt.Sprintf(names.Past, s)
+	}
+	return fmt.Sprintf(names.Future, s)
+}
+
+// humanizeUnit returns the unit and the number of it to describe the time
+// from from to to, where from is not after to.
+func humanizeUnit(from, to time.Time, th HumanizeThresholds, round func(float64) float64) (RelativeUnit, int) {
+	d := to.Sub(from) // saturated if the times are too far apart for the units below months
+	count := func(unit time.Duration) int {
+		return roundCount(float64(d)/float64(unit), round)
+	}
+	if n := count(time.Second); n < th.Seconds {
+		return RelativeSecond, n
+	}
+	if n := count(time.Minute); n < th.Minutes {
+		return RelativeMinute, n
+	}
+	if n := count(time.Hour); n < th.Hours {
+		return RelativeHour, n
+	}
+	if n := count(24 * time.Hour); n < th.Days {
+		return RelativeDay, n
+	}
+	if th.Weeks > 0 {
+		if n := count(humanizeWeek); n < th.Weeks {
+			return RelativeWeek, n
+		}
+	}
+	months := calendarMonths(from, to)
+	if n := roundCount(months, round); n < th.Months {
+		return RelativeMonth, n
+	}
+	return RelativeYear, roundCount(months/12, round)
+}
+
+// roundCount rounds the number of units x with round. It is at least 1.
+func roundCount(x float64, round func(float64) float64) int {
+	n := int(round(x))
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// calendarMonths returns the number of months from from to to, where from
+// is not after to. The whole months are counted from the calendar dates,
+// and the rest is the fraction of the month which follows them.
+// The day of month is clamped in the same way as AddISODuration, so that
+// January 31 to February 29 is a whole month.
+func calendarMonths(from, to time.Time) float64 {
+	whole := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	start := addCalendarMonths(from, whole)
+	for start.After(to) {
+		whole--
+		start = addCalendarMonths(from, whole)
+	}
+	next := addCalendarMonths(from, whole+1)
+	return float64(whole) + float64(to.Sub(start))/float64(next.Sub(start))
+}
+
+// addCalendarMonths adds months to t, clamping the day of month to the last
+// day of the resulting month.
+func addCalendarMonths(t time.Time, months int) time.Time {
+	year, month, day := addMonthsClamped(t.Year(), t.Month(), t.Day(), months)
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
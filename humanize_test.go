@@ -0,0 +1,196 @@
+package synchro_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_Humanize() {
+	ref := synchro.New[tz.AsiaTokyo](2024, 1, 15, 12, 0, 0, 0)
+
+	fmt.Println(ref.Add(-3 * time.Hour).Humanize(ref))
+	fmt.Println(ref.AddDate(0, 0, 2).Humanize(ref))
+	fmt.Println(ref.Add(-3*time.Hour).Humanize(ref, synchro.WithLocale(synchro.JapaneseLocale)))
+	fmt.Println(ref.AddDate(0, 0, 2).Humanize(ref, synchro.WithLocale(synchro.JapaneseLocale)))
+	// Output:
+	// 3 hours ago
+	// in 2 days
+	// 3時間前
+	// 2日後
+}
+
+func ExampleTime_HumanizeContext() {
+	now := synchro.New[tz.UTC](2024, 1, 15, 12, 0, 0, 0)
+	ctx := synchro.NowWithContext[tz.UTC](context.Background(), now)
+
+	posted := synchro.New[tz.UTC](2024, 1, 15, 11, 30, 0, 0)
+	fmt.Println(posted.HumanizeContext(ctx))
+	// Output: 30 minutes ago
+}
+
+func TestTime_Humanize(t *testing.T) {
+	ref := synchro.New[tz.UTC](2024, 1, 15, 12, 0, 0, 0)
+	day := 24 * time.Hour
+	tests := []struct {
+		name string
+		d    time.Duration
+		opts []synchro.HumanizeOptions
+		want string
+	}{
+		{name: "same", d: 0, want: "just now"},
+		{name: "just now", d: -9 * time.Second, want: "just now"},
+		{name: "seconds", d: -10 * time.Second, want: "10 seconds ago"},
+		{name: "seconds to minute", d: 45 * time.Second, want: "in 1 minute"},
+		{name: "one minute", d: -time.Minute, want: "1 minute ago"},
+		{name: "minutes", d: -44 * time.Minute, want: "44 minutes ago"},
+		{name: "minutes to hour", d: -45 * time.Minute, want: "1 hour ago"},
+		{name: "hours rounded", d: -(time.Hour + 50*time.Minute), want: "2 hours ago"},
+		{name: "hours to day", d: 22 * time.Hour, want: "in 1 day"},
+		{name: "days", d: -25 * day, want: "25 days ago"},
+		{name: "days to month", d: -26 * day, want: "1 month ago"},
+		{name: "months", d: 100 * day, want: "in 3 months"},
+		{name: "months to year", d: -330 * day, want: "1 year ago"},
+		{name: "years", d: -5 * 365 * day, want: "5 years ago"},
+		{
+			name: "floor rounding",
+			d:    -(time.Hour + 50*time.Minute),
+			opts: []synchro.HumanizeOptions{synchro.WithRounding(math.Floor)},
+			want: "1 hour ago",
+		},
+		{
+			name: "floor rounding to next unit",
+			d:    -45 * time.Minute,
+			opts: []synchro.HumanizeOptions{synchro.WithRounding(math.Floor)},
+			want: "1 hour ago",
+		},
+		{
+			name: "weeks",
+			d:    -15 * day,
+			opts: []synchro.HumanizeOptions{
+				synchro.WithHumanizeThresholds(synchro.HumanizeThresholds{
+					Seconds: 45, Minutes: 45, Hours: 22, Days: 7, Weeks: 4, Months: 11,
+				}),
+			},
+			want: "2 weeks ago",
+		},
+		{
+			name: "no just now",
+			d:    time.Second,
+			opts: []synchro.HumanizeOptions{
+				synchro.WithHumanizeThresholds(synchro.HumanizeThresholds{
+					Seconds: 60, Minutes: 60, Hours: 24, Days: 30, Months: 12,
+				}),
+			},
+			want: "in 1 second",
+		},
+		{
+			name: "japanese",
+			d:    -time.Minute,
+			opts: []synchro.HumanizeOptions{synchro.WithLocale(synchro.JapaneseLocale)},
+			want: "1分前",
+		},
+		{
+			name: "japanese just now",
+			d:    time.Second,
+			opts: []synchro.HumanizeOptions{synchro.WithLocale(synchro.JapaneseLocale)},
+			want: "たった今",
+		},
+		{
+			name: "japanese months",
+			d:    100 * day,
+			opts: []synchro.HumanizeOptions{synchro.WithLocale(synchro.JapaneseLocale)},
+			want: "3か月後",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ref.Add(tt.d).Humanize(ref, tt.opts...)
+			if got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_HumanizeFarApart(t *testing.T) {
+	// More than 292 years apart, which time.Duration cannot hold.
+	ref := synchro.New[tz.UTC](2024, 1, 15, 12, 0, 0, 0)
+	if got, want := ref.AddDate(-400, 0, 0).Humanize(ref), "400 years ago"; got != want {
+		t.Errorf("want %q but got %q", want, got)
+	}
+	if got, want := ref.AddDate(1000, 6, 0).Humanize(ref), "in 1001 years"; got != want {
+		t.Errorf("want %q but got %q", want, got)
+	}
+	if got, want := ref.AddDate(300, 0, 0).Humanize(ref, synchro.WithRounding(math.Floor)), "in 300 years"; got != want {
+		t.Errorf("want %q but got %q", want, got)
+	}
+}
+
+func TestTime_HumanizeMonthEnd(t *testing.T) {
+	// The day of month is clamped to the end of shorter months.
+	ref := synchro.New[tz.UTC](2024, 1, 31, 12, 0, 0, 0)
+	floor := synchro.WithRounding(math.Floor)
+	tests := []struct {
+		t    synchro.Time[tz.UTC]
+		want string
+	}{
+		{t: synchro.New[tz.UTC](2024, 2, 29, 12, 0, 0, 0), want: "in 1 month"},
+		{t: synchro.New[tz.UTC](2024, 4, 30, 12, 0, 0, 0), want: "in 3 months"},
+		{t: synchro.New[tz.UTC](2024, 4, 30, 11, 0, 0, 0), want: "in 2 months"},
+		{t: synchro.New[tz.UTC](2023, 11, 30, 12, 0, 0, 0), want: "2 months ago"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.t.String(), func(t *testing.T) {
+			if got := tt.t.Humanize(ref, floor); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_HumanizeIncompleteLocale(t *testing.T) {
+	ref := synchro.New[tz.UTC](2024, 1, 15, 12, 0, 0, 0)
+	l := &synchro.Locale{
+		Tag: "xx",
+		RelativeTime: synchro.RelativeTimeNames{
+			Future: "dentro de %s",
+			Units: map[synchro.RelativeUnit]synchro.PluralNames{
+				synchro.RelativeHour: {One: "%d hora", Other: "%d horas"},
+			},
+		},
+	}
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: 2 * time.Hour, want: "dentro de 2 horas"},
+		{d: 2 * 24 * time.Hour, want: "dentro de 2 days"},
+		{d: -2 * time.Hour, want: "2 horas ago"},
+		{d: 0, want: "just now"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := ref.Add(tt.d).Humanize(ref, synchro.WithLocale(l)); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_HumanizeDefaultReference(t *testing.T) {
+	// The current UTC time is fixed to `2023-09-02 14:00:00`.
+	now := synchro.Now[tz.UTC]()
+	past := now.Add(-2 * time.Hour)
+	if got, want := past.Humanize(synchro.Time[tz.UTC]{}), "2 hours ago"; got != want {
+		t.Errorf("Humanize: want %q but got %q", want, got)
+	}
+	if got, want := past.HumanizeContext(context.Background()), "2 hours ago"; got != want {
+		t.Errorf("HumanizeContext: want %q but got %q", want, got)
+	}
+}
@@ -0,0 +1,170 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// Interval represents a half-open time interval [start, end) in the timezone T.
+type Interval[T TimeZone] struct {
+	start Time[T]
+	end   Time[T]
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*Interval[tz.UTC])(nil)
+
+// NewInterval returns the Interval [start, end).
+// An error is returned if end is before start.
+func NewInterval[T TimeZone](start, end Time[T]) (Interval[T], error) {
+	if end.Before(start) {
+		return Interval[T]{}, fmt.Errorf("synchro: interval end %s is before start %s", end, start)
+	}
+	return Interval[T]{start: start, end: end}, nil
+}
+
+// IntervalFromPartialDate returns the Interval which covers all days in the
+// given ISO 8601 date with reduced precision, such as a year or a month,
+// in the timezone T.
+func IntervalFromPartialDate[T TimeZone](p iso8601.PartialDate) Interval[T] {
+	start := DateFromISO[T](p.Start())
+	end := DateFromISO[T](p.End()).AddDays(1)
+	return Interval[T]{start: start.StartOfDay(), end: end.StartOfDay()}
+}
+
+// ParsePartialDate parses an ISO8601-compliant date with reduced precision and
+// returns the Interval which covers all days in it in the timezone T.
+// Supported formats include:
+//
+//	Basic     Extended
+//	20        N/A         Century   [2000-01-01, 2100-01-01)
+//	2024      N/A         Year      [2024-01-01, 2025-01-01)
+//	N/A       2024-03     Month     [2024-03-01, 2024-04-01)
+//	2024W10   2024-W10    Week      [2024-03-04, 2024-03-11)
+func ParsePartialDate[T TimeZone](value string) (Interval[T], error) {
+	p, err := iso8601.ParsePartialDate(value)
+	if err != nil {
+		return Interval[T]{}, err
+	}
+	return IntervalFromPartialDate[T](p), nil
+}
+
+// ParseInterval parses an ISO8601-compliant time interval string and returns
+// the Interval it represents. Supported formats include:
+//
+//	<start>/<end>        2007-03-01T13:00:00Z/2008-05-11T15:30:00Z
+//	<start>/<duration>   2007-03-01T13:00:00Z/P1Y2M10DT2H30M
+//	<duration>/<end>     P1Y2M10DT2H30M/2008-05-11T15:30:00Z
+//	abbreviated end      2007-12-14T13:30/15:30
+//
+// The duration is applied calendar-correctly in the timezone T. See Time.AddISODuration.
+// See iso8601.ParseInterval for details.
+func ParseInterval[T TimeZone](value string) (Interval[T], error) {
+	var tz T
+	i, err := iso8601.ParseInterval(value, iso8601.WithInLocation(tz.Location()))
+	if err != nil {
+		return Interval[T]{}, err
+	}
+	return intervalFromISO[T](i)
+}
+
+func intervalFromISO[T TimeZone](i iso8601.Interval) (Interval[T], error) {
+	switch {
+	case !i.HasEnd():
+		start := In[T](i.Start)
+		return NewInterval(start, start.AddISODuration(i.Duration))
+	case !i.HasStart():
+		end := In[T](i.End)
+		return NewInterval(end.AddISODuration(i.Duration.Negate()), end)
+	}
+	return NewInterval(In[T](i.Start), In[T](i.End))
+}
+
+// Start returns the start of i.
+func (i Interval[T]) Start() Time[T] { return i.start }
+
+// End returns the end of i. The end is not included in i.
+func (i Interval[T]) End() Time[T] { return i.end }
+
+// Duration returns the elapsed time between the start and the end of i.
+func (i Interval[T]) Duration() time.Duration { return i.end.Sub(i.start) }
+
+// IsEmpty reports whether i contains no instants, that is, start equals end.
+func (i Interval[T]) IsEmpty() bool { return i.start.Equal(i.end) }
+
+// Contains reports whether t is within i, that is, start <= t < end.
+func (i Interval[T]) Contains(t Time[T]) bool {
+	return !t.Before(i.start) && t.Before(i.end)
+}
+
+// Overlaps reports whether i and u have any instants in common.
+func (i Interval[T]) Overlaps(u Interval[T]) bool {
+	return i.start.Before(u.end) && u.start.Before(i.end)
+}
+
+// Equal reports whether i and u have the same start and end.
+func (i Interval[T]) Equal(u Interval[T]) bool {
+	return i.start.Equal(u.start) && i.end.Equal(u.end)
+}
+
+// ISOInterval returns i as iso8601.Interval in the <start>/<end> form.
+func (i Interval[T]) ISOInterval() iso8601.Interval {
+	return iso8601.Interval{
+		Start: i.start.StdTime(),
+		End:   i.end.StdTime(),
+	}
+}
+
+// String returns the ISO8601 string representation of the format "<start>/<end>".
+// The start and end are formatted in RFC 3339 format with sub-second precision.
+// For example: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z".
+func (i Interval[T]) String() string {
+	return i.start.Format(time.RFC3339Nano) + "/" + i.end.Format(time.RFC3339Nano)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the same as String.
+func (i Interval[T]) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value must be in a format supported by ParseInterval.
+func (i *Interval[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseInterval[T](string(data))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is a quoted string which is the same as String.
+func (i Interval[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be a quoted string in a format supported by ParseInterval.
+func (i *Interval[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return i.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,246 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleParseInterval() {
+	i, _ := synchro.ParseInterval[tz.AsiaTokyo]("2024-01-31T10:00:00+09:00/P1M")
+	fmt.Println(i.Start())
+	fmt.Println(i.End())
+	fmt.Println(i)
+	// Output:
+	// 2024-01-31 10:00:00 +0900 JST
+	// 2024-02-29 10:00:00 +0900 JST
+	// 2024-01-31T10:00:00+09:00/2024-02-29T10:00:00+09:00
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantStart synchro.Time[tz.UTC]
+		wantEnd   synchro.Time[tz.UTC]
+		wantErr   bool
+	}{
+		{
+			value:     "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+			wantStart: synchro.New[tz.UTC](2007, 3, 1, 13, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2008, 5, 11, 15, 30, 0, 0),
+		},
+		{
+			value:     "2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
+			wantStart: synchro.New[tz.UTC](2007, 3, 1, 13, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2008, 5, 11, 15, 30, 0, 0),
+		},
+		{
+			value:     "P1Y2M10DT2H30M/2008-05-11T15:30:00Z",
+			wantStart: synchro.New[tz.UTC](2007, 3, 1, 13, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2008, 5, 11, 15, 30, 0, 0),
+		},
+		{
+			value:     "2007-12-14T13:30Z/15:30",
+			wantStart: synchro.New[tz.UTC](2007, 12, 14, 13, 30, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2007, 12, 14, 15, 30, 0, 0),
+		},
+		{
+			value:   "2008-05-11T15:30:00Z/2007-03-01T13:00:00Z",
+			wantErr: true,
+		},
+		{
+			value:   "2008-05-11T15:30:00Z",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseInterval[tz.UTC](tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.wantStart.Equal(got.Start()) {
+				t.Errorf("want start %s but got %s", tt.wantStart, got.Start())
+			}
+			if !tt.wantEnd.Equal(got.End()) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, got.End())
+			}
+		})
+	}
+}
+
+func ExampleParsePartialDate() {
+	i, _ := synchro.ParsePartialDate[tz.AsiaTokyo]("2024-W10")
+	fmt.Println(i.Start())
+	fmt.Println(i.End())
+	// Output:
+	// 2024-03-04 00:00:00 +0900 JST
+	// 2024-03-11 00:00:00 +0900 JST
+}
+
+func TestParsePartialDate(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantStart synchro.Time[tz.UTC]
+		wantEnd   synchro.Time[tz.UTC]
+		wantErr   bool
+	}{
+		{
+			value:     "20",
+			wantStart: synchro.New[tz.UTC](2000, 1, 1, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2100, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			value:     "2024",
+			wantStart: synchro.New[tz.UTC](2024, 1, 1, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2025, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			value:     "2024-02",
+			wantStart: synchro.New[tz.UTC](2024, 2, 1, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2024, 3, 1, 0, 0, 0, 0),
+		},
+		{
+			value:     "2020W53",
+			wantStart: synchro.New[tz.UTC](2020, 12, 28, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2021, 1, 4, 0, 0, 0, 0),
+		},
+		{
+			value:   "202402",
+			wantErr: true,
+		},
+		{
+			value:   "2024-02-01",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParsePartialDate[tz.UTC](tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.wantStart.Equal(got.Start()) {
+				t.Errorf("want start %s but got %s", tt.wantStart, got.Start())
+			}
+			if !tt.wantEnd.Equal(got.End()) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, got.End())
+			}
+		})
+	}
+}
+
+func TestInterval(t *testing.T) {
+	start := synchro.New[tz.UTC](2024, 1, 1, 10, 0, 0, 0)
+	end := synchro.New[tz.UTC](2024, 1, 1, 12, 0, 0, 0)
+	i, err := synchro.NewInterval(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := i.Duration(); got != 2*time.Hour {
+		t.Errorf("want 2h but got %v", got)
+	}
+	if i.IsEmpty() {
+		t.Errorf("should not be empty")
+	}
+
+	t.Run("Contains", func(t *testing.T) {
+		tests := []struct {
+			t    synchro.Time[tz.UTC]
+			want bool
+		}{
+			{t: start.Add(-1), want: false},
+			{t: start, want: true},
+			{t: start.Add(time.Hour), want: true},
+			{t: end.Add(-1), want: true},
+			{t: end, want: false},
+		}
+		for _, tt := range tests {
+			if got := i.Contains(tt.t); got != tt.want {
+				t.Errorf("Contains(%s): want %v but got %v", tt.t, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Overlaps", func(t *testing.T) {
+		tests := []struct {
+			start synchro.Time[tz.UTC]
+			end   synchro.Time[tz.UTC]
+			want  bool
+		}{
+			{start: start.Add(-time.Hour), end: start, want: false},
+			{start: start.Add(-time.Hour), end: start.Add(1), want: true},
+			{start: start.Add(time.Minute), end: end.Add(-time.Minute), want: true},
+			{start: end.Add(-1), end: end.Add(time.Hour), want: true},
+			{start: end, end: end.Add(time.Hour), want: false},
+		}
+		for _, tt := range tests {
+			u, err := synchro.NewInterval(tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := i.Overlaps(u); got != tt.want {
+				t.Errorf("Overlaps(%s): want %v but got %v", u, tt.want, got)
+			}
+			if got := u.Overlaps(i); got != tt.want {
+				t.Errorf("Overlaps(%s) reversed: want %v but got %v", u, tt.want, got)
+			}
+		}
+	})
+
+	if _, err := synchro.NewInterval(end, start); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestInterval_Marshal(t *testing.T) {
+	want, err := synchro.ParseInterval[tz.AsiaTokyo]("2024-01-01T10:00:00+09:00/PT1H30M")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := `"2024-01-01T10:00:00+09:00/2024-01-01T11:30:00+09:00"`; string(b) != s {
+		t.Errorf("want %s but got %s", s, b)
+	}
+	var got synchro.Interval[tz.AsiaTokyo]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scanned synchro.Interval[tz.AsiaTokyo]
+	if err := scanned.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !scanned.Equal(want) {
+		t.Errorf("want %s but got %s", want, scanned)
+	}
+	if err := scanned.Scan(1); err == nil {
+		t.Errorf("expected error")
+	}
+}
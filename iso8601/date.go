@@ -2,7 +2,6 @@ package iso8601
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"time"
 )
@@ -10,7 +9,7 @@ import (
 // NOTE(codehex): "math.MaxInt == 9223372036854775807" has 19 digits.
 // So I consider the maximum to be 18 digits, which is "999999999999999999."
 
-func countDigits(b []byte, i int) int {
+func countDigits[bytes []byte | ~string](b bytes, i int) int {
 	start := i
 	for ; i < len(b); i++ {
 		c := b[i] - '0'
@@ -21,13 +20,12 @@ func countDigits(b []byte, i int) int {
 	return i - start
 }
 
-func parseNumber(b []byte, start, width int) (v int) {
+func parseNumber[bytes []byte | ~string](b bytes, start, width int) (v int) {
 	if len(b) <= start {
 		return
 	}
-	for i := width; i > 0; i-- {
-		v += int(b[start]-'0') * int(math.Pow10(i-1))
-		start++
+	for i := start; i < start+width; i++ {
+		v = v*10 + int(b[i]-'0')
 	}
 	return
 }
@@ -41,9 +39,16 @@ func parseNumber(b []byte, start, width int) (v int) {
 //	2012W521        2012-W52-1    Week date       (ISO 8601)
 //	2012Q485        2012-Q4-85    Quarter date
 //
+// Only WithExpandedYear among the options affects the result. With the
+// option, the years are parsed in the expanded representation such as
+// "+012024-03-01" or "-000044-075". Note that the Validate methods of the
+// returned DateLike report the years out of the range [0,9999] as invalid.
+//
 // The function returns an implementation of DateLike or an error if the parsing fails.
-func ParseDate[bytes []byte | ~string](b bytes) (DateLike, error) {
-	n, d, err := parseDate([]byte(b))
+// b is not copied, and the only allocation on success is for the returned DateLike.
+func ParseDate[bytes []byte | ~string](b bytes, opts ...ParseDateTimeOptions) (DateLike, error) {
+	o := newParseDateTimeOptions(opts)
+	n, d, err := parseDate(b, o.expandedYear)
 	if err != nil {
 		return nil, err
 	}
@@ -53,192 +58,312 @@ func ParseDate[bytes []byte | ~string](b bytes) (DateLike, error) {
 			Token:      string(b[n:]),
 			AfterToken: string(b[:n]),
 			Expected:   string(b[:n]),
+			Offset:     n,
+			Length:     len(b) - n,
+			Kind:       KindExtraText,
+		}
+	}
+	return d.dateLike(), nil
+}
+
+// parsedDate is a date parsed in its own representation. It is used
+// instead of DateLike internally to avoid allocations.
+type parsedDate struct {
+	form DateForm
+	year int
+	x    int // month or week or quarter
+	day  int
+}
+
+// dateLike returns the DateLike of the representation.
+func (p parsedDate) dateLike() DateLike {
+	switch p.form {
+	case OrdinalDateForm:
+		return OrdinalDate{Year: p.year, Day: p.day}
+	case WeekDateForm:
+		return WeekDate{Year: p.year, Week: p.x, Day: p.day}
+	case QuarterDateForm:
+		return QuarterDate{Year: p.year, Quarter: p.x, Day: p.day}
+	}
+	return Date{Year: p.year, Month: time.Month(p.x), Day: p.day}
+}
+
+// date is the same as p.dateLike().Date() but does not allocate.
+func (p parsedDate) date() Date {
+	switch p.form {
+	case OrdinalDateForm:
+		return OrdinalDate{Year: p.year, Day: p.day}.Date()
+	case WeekDateForm:
+		return WeekDate{Year: p.year, Week: p.x, Day: p.day}.Date()
+	case QuarterDateForm:
+		return QuarterDate{Year: p.year, Quarter: p.x, Day: p.day}.Date()
+	}
+	return Date{Year: p.year, Month: time.Month(p.x), Day: p.day}
+}
+
+func parseDate[bytes []byte | ~string](b bytes, expanded int) (int, parsedDate, error) {
+	// To allow leading '+' signed year components.
+	// The expanded representation always has a sign.
+	yw := 4 // the number of year digits
+	signed, negative := 0, false
+	if expanded > 0 {
+		if len(b) == 0 || (b[0] != '+' && b[0] != '-') {
+			var token string
+			if len(b) > 0 {
+				token = string(b[0])
+			}
+			return 0, parsedDate{}, &UnexpectedTokenError{
+				Value:    string(b),
+				Token:    token,
+				Expected: "+ or - sign of the expanded year",
+				Length:   len(token),
+				Kind:     tokenKind(b, 0),
+			}
 		}
+		yw += expanded
+		negative = b[0] == '-'
+	}
+	if len(b) > 0 && (b[0] == '+' || negative) {
+		signed++
+	}
+	n, d, err := parseUnsignedDate(b[signed:], yw, negative)
+	if err != nil {
+		return 0, parsedDate{}, overrideErrorPosition(err, b, signed)
 	}
-	return d, err
+	return n + signed, d, nil
 }
 
-func parseDate(b []byte) (int, DateLike, error) {
+// parseUnsignedDate parses the date whose year has yw digits without the sign.
+func parseUnsignedDate[bytes []byte | ~string](b bytes, yw int, negative bool) (int, parsedDate, error) {
 	var (
 		y int
 		x int // month or week or quarter
 		d int
 	)
 
-	// To allow leading '+' signed year components.
-	signed := 0
-	if len(b) > 0 && b[0] == '+' {
-		b = b[1:]
-		signed++
+	year := func() int {
+		if negative {
+			return -parseNumber(b, 0, yw)
+		}
+		return parseNumber(b, 0, yw)
 	}
 
 	n := countDigits(b, 0)
 	switch n {
-	case 4: /* 2012 (year) */
-		y = parseNumber(b, 0, 4)
-		if len(b) < 8 {
-			return 0, nil, &UnexpectedTokenError{
+	case yw: /* 2012 (year) */
+		y = year()
+		if len(b) < yw+4 {
+			return 0, parsedDate{}, &UnexpectedTokenError{
 				Value:      string(b),
-				Token:      string(b[4:]),
+				Token:      string(b[yw:]),
 				AfterToken: strconv.Itoa(y),
-				Expected:   "8 or more characters",
+				Expected:   fmt.Sprintf("%d or more characters", yw+4),
+				Offset:     yw,
+				Length:     len(b) - yw,
+				Kind:       KindUnexpectedEnd,
 			}
 		}
 
-		n = countDigits(b, 5)
-		switch b[4] {
+		n = countDigits(b, yw+1)
+		switch b[yw] {
 		case '-': // 2012-359 | 2012-12-24 | 2012-W52-1 | 2012-Q4-85
 		case 'Q': // 2012Q485
 			if n != 3 {
-				return 0, nil, &UnexpectedTokenError{
+				return 0, parsedDate{}, &UnexpectedTokenError{
 					Value:      string(b),
 					Token:      humanizeDigits(n),
 					AfterToken: "Q",
 					Expected:   humanizeDigits(3),
+					Offset:     yw + 1,
+					Length:     tokenLength(b, yw+1, n),
+					Kind:       tokenKind(b, yw+1),
 				}
 			}
-			x = parseNumber(b, 5, 1)
-			d = parseNumber(b, 6, 2)
-			dt, err := yqdISODate(y, x, d)
-			return 8 + signed, dt, err
+			x = parseNumber(b, yw+1, 1)
+			d = parseNumber(b, yw+2, 2)
+			dt, err := yqdISODate(y, x, d, yw+1, yw+2)
+			return yw + 4, dt, err
 		case 'W': // 2012W521
 			if n != 3 {
-				return 0, nil, &UnexpectedTokenError{
+				return 0, parsedDate{}, &UnexpectedTokenError{
 					Value:      string(b),
 					Token:      humanizeDigits(n),
 					AfterToken: "W",
 					Expected:   humanizeDigits(3),
+					Offset:     yw + 1,
+					Length:     tokenLength(b, yw+1, n),
+					Kind:       tokenKind(b, yw+1),
 				}
 			}
-			x = parseNumber(b, 5, 2)
-			d = parseNumber(b, 7, 1)
-			dt, err := ywdISODate(y, x, d)
-			return 8 + signed, dt, err
+			x = parseNumber(b, yw+1, 2)
+			d = parseNumber(b, yw+3, 1)
+			dt, err := ywdISODate(y, x, d, yw+1, yw+3)
+			return yw + 4, dt, err
 		default:
-			return 0, nil, &UnexpectedTokenError{
+			return 0, parsedDate{}, &UnexpectedTokenError{
 				Value:      string(b),
-				Token:      string(b[4:]),
+				Token:      string(b[yw:]),
 				AfterToken: strconv.Itoa(y),
 				Expected:   "- or Q or W",
+				Offset:     yw,
+				Length:     len(b) - yw,
+				Kind:       KindUnexpectedToken,
 			}
 		}
 
 		switch n {
 		case 0: // 2012-Q4-85 | 2012-W52-1
-			if len(b) >= 10 {
-				n = countDigits(b, 6)
-				switch b[5] {
+			if len(b) >= yw+6 {
+				n = countDigits(b, yw+2)
+				switch b[yw+1] {
 				case 'Q': // 2012-Q4-85
 					if n != 1 {
-						return 0, nil, &UnexpectedTokenError{
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
 							Token:      humanizeDigits(n),
 							AfterToken: "Q",
 							Expected:   humanizeDigits(1),
+							Offset:     yw + 2,
+							Length:     tokenLength(b, yw+2, n),
+							Kind:       tokenKind(b, yw+2),
 						}
 					}
-					x = parseNumber(b, 6, 1)
-					if b[7] != '-' {
-						return 0, nil, &UnexpectedTokenError{
+					x = parseNumber(b, yw+2, 1)
+					if b[yw+3] != '-' {
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
-							Token:      string(b[7]),
+							Token:      string(b[yw+3]),
 							AfterToken: fmt.Sprintf("Q%d", x),
 							Expected:   "-",
+							Offset:     yw + 3,
+							Length:     1,
+							Kind:       KindUnexpectedToken,
 						}
 					}
-					if c := countDigits(b, 8); c != 2 {
-						return 0, nil, &UnexpectedTokenError{
+					if c := countDigits(b, yw+4); c != 2 {
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
 							Token:      humanizeDigits(c),
 							AfterToken: fmt.Sprintf("Q%d-", x),
 							Expected:   humanizeDigits(2),
+							Offset:     yw + 4,
+							Length:     tokenLength(b, yw+4, c),
+							Kind:       tokenKind(b, yw+4),
 						}
 					}
-					d = parseNumber(b, 8, 2)
-					dt, err := yqdISODate(y, x, d)
-					return 10 + signed, dt, err
+					d = parseNumber(b, yw+4, 2)
+					dt, err := yqdISODate(y, x, d, yw+2, yw+4)
+					return yw + 6, dt, err
 				case 'W': // 2012-W52-1
 					if n != 2 {
-						return 0, nil, &UnexpectedTokenError{
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
 							Token:      humanizeDigits(n),
 							AfterToken: "W",
 							Expected:   humanizeDigits(2),
+							Offset:     yw + 2,
+							Length:     tokenLength(b, yw+2, n),
+							Kind:       tokenKind(b, yw+2),
 						}
 					}
-					x = parseNumber(b, 6, 2)
-					if b[8] != '-' {
-						return 0, nil, &UnexpectedTokenError{
+					x = parseNumber(b, yw+2, 2)
+					if b[yw+4] != '-' {
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
-							Token:      string(b[8]),
+							Token:      string(b[yw+4]),
 							AfterToken: fmt.Sprintf("W%02d", x),
 							Expected:   "-",
+							Offset:     yw + 4,
+							Length:     1,
+							Kind:       KindUnexpectedToken,
 						}
 					}
-					if c := countDigits(b, 9); c != 1 {
-						return 0, nil, &UnexpectedTokenError{
+					if c := countDigits(b, yw+5); c != 1 {
+						return 0, parsedDate{}, &UnexpectedTokenError{
 							Value:      string(b),
 							Token:      humanizeDigits(c),
 							AfterToken: fmt.Sprintf("W%02d-", x),
 							Expected:   humanizeDigits(1),
+							Offset:     yw + 5,
+							Length:     tokenLength(b, yw+5, c),
+							Kind:       tokenKind(b, yw+5),
 						}
 					}
-					d = parseNumber(b, 9, 1)
-					dt, err := ywdISODate(y, x, d)
-					return 10 + signed, dt, err
+					d = parseNumber(b, yw+5, 1)
+					dt, err := ywdISODate(y, x, d, yw+2, yw+5)
+					return yw + 6, dt, err
 				}
 			}
+			return 0, parsedDate{}, &UnexpectedTokenError{
+				Value:    string(b),
+				Token:    humanizeDigits(n),
+				Expected: "date format",
+				Offset:   yw + 1,
+				Length:   tokenLength(b, yw+1, 0),
+				Kind:     tokenKind(b, yw+1),
+			}
 		case 2: // 2012-12-24
-			x = parseNumber(b, 5, 2)
-			if b[7] != '-' {
-				return 0, nil, &UnexpectedTokenError{
+			x = parseNumber(b, yw+1, 2)
+			if b[yw+3] != '-' {
+				return 0, parsedDate{}, &UnexpectedTokenError{
 					Value:      string(b),
-					Token:      string(b[7]),
+					Token:      string(b[yw+3]),
 					AfterToken: fmt.Sprintf("-%02d", x),
 					Expected:   "-",
+					Offset:     yw + 3,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
-			if c := countDigits(b, 8); c != 2 {
-				return 0, nil, &UnexpectedTokenError{
+			if c := countDigits(b, yw+4); c != 2 {
+				return 0, parsedDate{}, &UnexpectedTokenError{
 					Value:      string(b),
 					Token:      humanizeDigits(c),
 					AfterToken: fmt.Sprintf("-%02d-", x),
 					Expected:   humanizeDigits(2),
+					Offset:     yw + 4,
+					Length:     tokenLength(b, yw+4, c),
+					Kind:       tokenKind(b, yw+4),
 				}
 			}
-			d = parseNumber(b, 8, 2)
-			dt, err := ymdISODate(y, x, d)
-			return 10 + signed, dt, err
+			d = parseNumber(b, yw+4, 2)
+			dt, err := ymdISODate(y, x, d, yw+1, yw+4)
+			return yw + 6, dt, err
 		case 3: // 2012-359
-			d = parseNumber(b, 5, 3)
-			dt, err := ydISODate(y, d)
-			return 8 + signed, dt, err
+			d = parseNumber(b, yw+1, 3)
+			dt, err := ydISODate(y, d, yw+1)
+			return yw + 4, dt, err
 		default:
-			return 0, nil, &UnexpectedTokenError{
+			return 0, parsedDate{}, &UnexpectedTokenError{
 				Value:      string(b),
 				Token:      humanizeDigits(n),
 				AfterToken: fmt.Sprintf("%d-", y),
 				Expected:   "like -Q4-85 or -W52-1 or -359",
+				Offset:     yw + 1,
+				Length:     n,
+				Kind:       KindUnexpectedToken,
 			}
 		}
-	case 7: // 2012359 (basic ordinal date)
-		y = parseNumber(b, 0, 4)
-		d = parseNumber(b, 4, 3)
-		dt, err := ydISODate(y, d)
-		return 7 + signed, dt, err
-	case 8: // 20121224 (basic calendar date)
-		y = parseNumber(b, 0, 4)
-		x = parseNumber(b, 4, 2)
-		d = parseNumber(b, 6, 2)
-		dt, err := ymdISODate(y, x, d)
-		return 8 + signed, dt, err
+	case yw + 3: // 2012359 (basic ordinal date)
+		y = year()
+		d = parseNumber(b, yw, 3)
+		dt, err := ydISODate(y, d, yw)
+		return yw + 3, dt, err
+	case yw + 4: // 20121224 (basic calendar date)
+		y = year()
+		x = parseNumber(b, yw, 2)
+		d = parseNumber(b, yw+2, 2)
+		dt, err := ymdISODate(y, x, d, yw, yw+2)
+		return yw + 4, dt, err
 	default:
 	}
-	return 0, nil, &UnexpectedTokenError{
+	return 0, parsedDate{}, &UnexpectedTokenError{
 		Value:      string(b),
 		Token:      humanizeDigits(n),
 		AfterToken: "",
 		Expected:   "date format",
+		Length:     tokenLength(b, 0, n),
+		Kind:       tokenKind(b, 0),
 	}
 }
 
@@ -249,51 +374,75 @@ func humanizeDigits(n int) string {
 	return fmt.Sprintf("%d-digits", n)
 }
 
-func ydISODate(y int, d int) (DateLike, error) {
+func ydISODate(y int, d int, dOffset int) (parsedDate, error) {
 	yd := OrdinalDate{
 		Year: y,
 		Day:  d,
 	}
-	if err := yd.Validate(); err != nil {
-		return nil, err
+	if err := yd.validateInYear(); err != nil {
+		return parsedDate{}, withElementPosition(err, map[string][2]int{
+			"day of year": {dOffset, 3},
+		})
 	}
-	return yd, nil
+	return parsedDate{form: OrdinalDateForm, year: y, day: d}, nil
 }
 
-func ymdISODate(y int, m int, d int) (DateLike, error) {
+func ymdISODate(y int, m int, d int, mOffset, dOffset int) (parsedDate, error) {
 	ymd := Date{
 		Year:  y,
 		Month: time.Month(m),
 		Day:   d,
 	}
-	if err := ymd.Validate(); err != nil {
-		return nil, err
+	if err := ymd.validateInYear(); err != nil {
+		return parsedDate{}, withElementPosition(err, map[string][2]int{
+			"month":        {mOffset, 2},
+			"day of month": {dOffset, 2},
+		})
 	}
-	return ymd, nil
+	return parsedDate{form: CalendarDateForm, year: y, x: m, day: d}, nil
 }
 
-func yqdISODate(y int, q int, d int) (DateLike, error) {
+func yqdISODate(y int, q int, d int, qOffset, dOffset int) (parsedDate, error) {
 	yqd := QuarterDate{
 		Year:    y,
 		Quarter: q,
 		Day:     d,
 	}
-	if err := yqd.Validate(); err != nil {
-		return nil, err
+	if err := yqd.validateInYear(); err != nil {
+		return parsedDate{}, withElementPosition(err, map[string][2]int{
+			"quarter":        {qOffset, 1},
+			"day of quarter": {dOffset, 2},
+		})
 	}
-	return yqd, nil
+	return parsedDate{form: QuarterDateForm, year: y, x: q, day: d}, nil
 }
 
-func ywdISODate(y int, w int, d int) (DateLike, error) {
+func ywdISODate(y int, w int, d int, wOffset, dOffset int) (parsedDate, error) {
 	ywd := WeekDate{
 		Year: y,
 		Week: w,
 		Day:  d,
 	}
-	if err := ywd.Validate(); err != nil {
-		return nil, err
+	if err := ywd.validateInYear(); err != nil {
+		return parsedDate{}, withElementPosition(err, map[string][2]int{
+			"week":        {wOffset, 2},
+			"day of week": {dOffset, 1},
+		})
+	}
+	return parsedDate{form: WeekDateForm, year: y, x: w, day: d}, nil
+}
+
+func validateYear(year int) error {
+	if year < 0 || year > 9999 {
+		return &DateLikeRangeError{
+			Element: "year",
+			Value:   year,
+			Year:    year,
+			Min:     0,
+			Max:     9999,
+		}
 	}
-	return ywd, nil
+	return nil
 }
 
 func daysInYear(y int) int {
@@ -384,15 +533,14 @@ func (d Date) IsValid() bool {
 // Validate checks the individual components of the date (year, month, and day)
 // and returns an error if any of them are out of the expected ranges.
 func (d Date) Validate() error {
-	if d.Year < 0 || d.Year > 9999 {
-		return &DateLikeRangeError{
-			Element: "year",
-			Value:   d.Year,
-			Year:    d.Year,
-			Min:     0,
-			Max:     9999,
-		}
+	if err := validateYear(d.Year); err != nil {
+		return err
 	}
+	return d.validateInYear()
+}
+
+// validateInYear checks the components of the date other than the year.
+func (d Date) validateInYear() error {
 	if d.Month < 1 || d.Month > 12 {
 		return &DateLikeRangeError{
 			Element: "month",
@@ -463,15 +611,14 @@ func (q QuarterDate) IsValid() bool {
 // Validate checks the individual components of the quarter date (year, quarter, and day within the quarter)
 // and returns an error if any of them are out of the expected ranges.
 func (q QuarterDate) Validate() error {
-	if q.Year < 0 || q.Year > 9999 {
-		return &DateLikeRangeError{
-			Element: "year",
-			Value:   q.Year,
-			Year:    q.Year,
-			Min:     0,
-			Max:     9999,
-		}
+	if err := validateYear(q.Year); err != nil {
+		return err
 	}
+	return q.validateInYear()
+}
+
+// validateInYear checks the components of the quarter date other than the year.
+func (q QuarterDate) validateInYear() error {
 	if q.Quarter < 1 || q.Quarter > 4 {
 		return &DateLikeRangeError{
 			Element: "quarter",
@@ -543,15 +690,14 @@ func (w WeekDate) IsValid() bool {
 // Validate checks the individual components of the week date (year, week number, and day of the week)
 // and returns an error if any of them are out of the expected ranges.
 func (w WeekDate) Validate() error {
-	if w.Year < 0 || w.Year > 9999 {
-		return &DateLikeRangeError{
-			Element: "year",
-			Value:   w.Year,
-			Year:    w.Year,
-			Min:     0,
-			Max:     9999,
-		}
+	if err := validateYear(w.Year); err != nil {
+		return err
 	}
+	return w.validateInYear()
+}
+
+// validateInYear checks the components of the week date other than the year.
+func (w WeekDate) validateInYear() error {
 	if w.Day < 1 || w.Day > 7 {
 		return &DateLikeRangeError{
 			Element: "day of week",
@@ -613,15 +759,14 @@ func (o OrdinalDate) IsValid() bool {
 // Validate checks the individual components of the ordinal date (year and day-of-year)
 // and returns an error if any of them are out of the expected ranges.
 func (o OrdinalDate) Validate() error {
-	if o.Year < 0 || o.Year > 9999 {
-		return &DateLikeRangeError{
-			Element: "year",
-			Value:   o.Year,
-			Year:    o.Year,
-			Min:     0,
-			Max:     9999,
-		}
+	if err := validateYear(o.Year); err != nil {
+		return err
 	}
+	return o.validateInYear()
+}
+
+// validateInYear checks the components of the ordinal date other than the year.
+func (o OrdinalDate) validateInYear() error {
 	daysInYear := daysInYear(o.Year)
 	if o.Day < 1 || o.Day > daysInYear {
 		return &DateLikeRangeError{
@@ -636,15 +781,44 @@ func (o OrdinalDate) Validate() error {
 }
 
 // DateLikeRangeError indicates that a value is not in an expected range for DateLike.
+//
+// If the error is returned by the parsing functions, Offset and Length are
+// the position of the element in the parsed value in bytes.
 type DateLikeRangeError struct {
 	Element string
 	Value   int
 	Year    int
 	Min     int
 	Max     int
+
+	Offset int
+	Length int
 }
 
+var _ PositionError = (*DateLikeRangeError)(nil)
+
 // Error implements the error interface.
 func (e *DateLikeRangeError) Error() string {
 	return fmt.Sprintf("iso8601: %d %s is not in range %d-%d in %d", e.Value, e.Element, e.Min, e.Max, e.Year)
 }
+
+// Position implements the PositionError interface.
+func (e *DateLikeRangeError) Position() (offset, length int) {
+	return e.Offset, e.Length
+}
+
+// ErrorKind implements the PositionError interface. It always returns KindOutOfRange.
+func (e *DateLikeRangeError) ErrorKind() ErrorKind {
+	return KindOutOfRange
+}
+
+// withElementPosition sets the position of the element which caused err.
+// positions are the pairs of the offset and the length of elements.
+func withElementPosition(err error, positions map[string][2]int) error {
+	if e, ok := err.(*DateLikeRangeError); ok {
+		if p, ok := positions[e.Element]; ok {
+			e.Offset, e.Length = p[0], p[1]
+		}
+	}
+	return err
+}
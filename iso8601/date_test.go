@@ -175,6 +175,8 @@ func Test_ParseDate(t *testing.T) {
 				Value:    "20",
 				Token:    humanizeDigits(2),
 				Expected: "date format",
+				Length:   2,
+				Kind:     KindUnexpectedToken,
 			},
 		},
 		{
@@ -184,6 +186,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "/",
 				AfterToken: "2000",
 				Expected:   "8 or more characters",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -193,6 +198,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "Q1",
 				AfterToken: "2000",
 				Expected:   "8 or more characters",
+				Offset:     4,
+				Length:     2,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -202,6 +210,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "Q12",
 				AfterToken: "2000",
 				Expected:   "8 or more characters",
+				Offset:     4,
+				Length:     3,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -211,6 +222,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "W1",
 				AfterToken: "2000",
 				Expected:   "8 or more characters",
+				Offset:     4,
+				Length:     2,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -220,6 +234,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "W12",
 				AfterToken: "2000",
 				Expected:   "8 or more characters",
+				Offset:     4,
+				Length:     3,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -229,6 +246,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "X1234",
 				AfterToken: "2000",
 				Expected:   "- or Q or W",
+				Offset:     4,
+				Length:     5,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -238,6 +258,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(4),
 				AfterToken: "Q",
 				Expected:   humanizeDigits(3),
+				Offset:     5,
+				Length:     4,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -247,6 +270,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(4),
 				AfterToken: "W",
 				Expected:   humanizeDigits(3),
+				Offset:     5,
+				Length:     4,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -256,6 +282,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(2),
 				AfterToken: "Q",
 				Expected:   humanizeDigits(1),
+				Offset:     6,
+				Length:     2,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -265,6 +294,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "=",
 				AfterToken: "Q1",
 				Expected:   "-",
+				Offset:     7,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -274,6 +306,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "Q1-",
 				Expected:   humanizeDigits(2),
+				Offset:     8,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -283,6 +318,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "W",
 				Expected:   humanizeDigits(2),
+				Offset:     6,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -292,6 +330,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(1),
 				AfterToken: "W",
 				Expected:   humanizeDigits(2),
+				Offset:     6,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -301,6 +342,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "=",
 				AfterToken: "W12",
 				Expected:   "-",
+				Offset:     8,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -310,6 +354,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(2),
 				AfterToken: "W12-",
 				Expected:   humanizeDigits(1),
+				Offset:     9,
+				Length:     2,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -319,6 +366,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "~",
 				AfterToken: "-12",
 				Expected:   "-",
+				Offset:     7,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -328,6 +378,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "-12-",
 				Expected:   humanizeDigits(2),
+				Offset:     8,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -337,6 +390,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      humanizeDigits(5),
 				AfterToken: "2000-",
 				Expected:   "like -Q4-85 or -W52-1 or -359",
+				Offset:     5,
+				Length:     5,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		// valid format but range is invalid
@@ -348,6 +404,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     12,
+				Offset:  4,
+				Length:  2,
 			},
 		},
 		{
@@ -358,6 +416,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2011,
 				Min:     1,
 				Max:     28,
+				Offset:  6,
+				Length:  2,
 			},
 		},
 		{
@@ -368,6 +428,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     29,
+				Offset:  6,
+				Length:  2,
 			},
 		},
 		{
@@ -378,6 +440,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     366,
+				Offset:  4,
+				Length:  3,
 			},
 		},
 		{
@@ -388,6 +452,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2013,
 				Min:     1,
 				Max:     365,
+				Offset:  4,
+				Length:  3,
 			},
 		},
 		{
@@ -398,6 +464,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     7,
+				Offset:  7,
+				Length:  1,
 			},
 		},
 		{
@@ -408,6 +476,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     7,
+				Offset:  7,
+				Length:  1,
 			},
 		},
 		{
@@ -418,6 +488,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     52,
+				Offset:  5,
+				Length:  2,
 			},
 		},
 		{
@@ -428,6 +500,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     4,
+				Offset:  5,
+				Length:  1,
 			},
 		},
 		{
@@ -438,6 +512,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2012,
 				Min:     1,
 				Max:     91,
+				Offset:  6,
+				Length:  2,
 			},
 		},
 		{
@@ -448,6 +524,8 @@ func Test_ParseDate(t *testing.T) {
 				Year:    2013,
 				Min:     1,
 				Max:     90,
+				Offset:  6,
+				Length:  2,
 			},
 		},
 		{
@@ -457,6 +535,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "Hello",
 				AfterToken: "20121224",
 				Expected:   "20121224",
+				Offset:     8,
+				Length:     5,
+				Kind:       KindExtraText,
 			},
 		},
 		{
@@ -466,6 +547,9 @@ func Test_ParseDate(t *testing.T) {
 				Token:      "Hello",
 				AfterToken: "+0000-366",
 				Expected:   "+0000-366",
+				Offset:     9,
+				Length:     5,
+				Kind:       KindExtraText,
 			},
 		},
 	}
@@ -488,6 +572,132 @@ func Test_ParseDate(t *testing.T) {
 	}
 }
 
+func Test_ParseDate_ExpandedYear(t *testing.T) {
+	tests := []struct {
+		name    string
+		extra   int
+		want    DateLike
+		wantErr error
+	}{
+		{
+			name:  "+012024-03-01",
+			extra: 2,
+			want:  Date{Year: 12024, Month: time.March, Day: 1},
+		},
+		{
+			name:  "-000044-03-15",
+			extra: 2,
+			want:  Date{Year: -44, Month: time.March, Day: 15},
+		},
+		{
+			name:  "+020240301",
+			extra: 1,
+			want:  Date{Year: 2024, Month: time.March, Day: 1},
+		},
+		{
+			name:  "-00001-074",
+			extra: 1,
+			want:  OrdinalDate{Year: -1, Day: 74},
+		},
+		{
+			name:  "-000001W011",
+			extra: 2,
+			want:  WeekDate{Year: -1, Week: 1, Day: 1},
+		},
+		{
+			name:  "+100000-Q1-01",
+			extra: 2,
+			want:  QuarterDate{Year: 100000, Quarter: 1, Day: 1},
+		},
+		{
+			name:  "+2024-03-01",
+			extra: 0,
+			want:  Date{Year: 2024, Month: time.March, Day: 1},
+		},
+		{
+			name:  "-000004-02-29",
+			extra: 2,
+			want:  Date{Year: -4, Month: time.February, Day: 29},
+		},
+		{
+			name:  "-000001-02-29",
+			extra: 2,
+			wantErr: &DateLikeRangeError{
+				Element: "day of month",
+				Value:   29,
+				Year:    -1,
+				Min:     1,
+				Max:     28,
+				Offset:  11,
+				Length:  2,
+			},
+		},
+		{
+			name:  "012024-03-01",
+			extra: 2,
+			wantErr: &UnexpectedTokenError{
+				Value:    "012024-03-01",
+				Token:    "0",
+				Expected: "+ or - sign of the expanded year",
+				Length:   1,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			name:  "+2024-03-01",
+			extra: 2,
+			wantErr: &UnexpectedTokenError{
+				Value:    "+2024-03-01",
+				Token:    "4-digits",
+				Expected: "date format",
+				Offset:   1,
+				Length:   4,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			name:  "-2024-03-01",
+			extra: 0,
+			wantErr: &UnexpectedTokenError{
+				Value:    "-2024-03-01",
+				Token:    "0-digit",
+				Expected: "date format",
+				Length:   1,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			name:  "+012024",
+			extra: 2,
+			wantErr: &UnexpectedTokenError{
+				Value:      "+012024",
+				Token:      "",
+				AfterToken: "12024",
+				Expected:   "10 or more characters",
+				Offset:     7,
+				Kind:       KindUnexpectedEnd,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDate(tt.name, WithExpandedYear(tt.extra))
+			if tt.wantErr != nil {
+				if diff := cmp.Diff(tt.wantErr, err); diff != "" {
+					t.Errorf("error: (-want, +got)\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_countDigits(t *testing.T) {
 	type args struct {
 		b []byte
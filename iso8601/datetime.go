@@ -3,30 +3,111 @@ package iso8601
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var defaultParseDateTimeOptions = parseDateTimeOptions{
-	timeDesignators: []byte{'T'},
+	timeDesignators: timeDesignators{n: 1, chars: [8]byte{'T'}},
 	local:           time.Local,
 }
 
 type parseDateTimeOptions struct {
-	timeDesignators []byte
+	timeDesignators timeDesignators
 	local           *time.Location
+	withoutZone     bool
+	expandedYear    int
+	profile         Profile
 }
 
 // ParseDateTimeOptions is a function type that modifies the parsing behavior
 // of a datetime string. It acts as a functional option.
 type ParseDateTimeOptions func(*parseDateTimeOptions)
 
+// parseDateTimeOptionsPool holds the options which the option functions are
+// applied to. The options escape to the heap through the function calls,
+// so they are reused to parse without allocation.
+var parseDateTimeOptionsPool = sync.Pool{
+	New: func() any { return new(parseDateTimeOptions) },
+}
+
+// newParseDateTimeOptions returns the default options modified by opts.
+func newParseDateTimeOptions(opts []ParseDateTimeOptions) parseDateTimeOptions {
+	return applyParseDateTimeOptions(defaultParseDateTimeOptions, opts)
+}
+
+// applyParseDateTimeOptions returns the options base modified by opts.
+// It does not allocate in the steady state.
+func applyParseDateTimeOptions(base parseDateTimeOptions, opts []ParseDateTimeOptions) parseDateTimeOptions {
+	if len(opts) == 0 {
+		return base
+	}
+	o := parseDateTimeOptionsPool.Get().(*parseDateTimeOptions)
+	*o = base
+	for _, opt := range opts {
+		opt(o)
+	}
+	base = *o
+	*o = parseDateTimeOptions{}
+	parseDateTimeOptionsPool.Put(o)
+	return base
+}
+
+// timeDesignators is the ordered set of the characters which can be used as
+// time designators. The characters are held in an array so that the options
+// are copied without sharing them.
+type timeDesignators struct {
+	n     int
+	chars [8]byte
+	more  []byte // the characters which do not fit in chars
+}
+
+// set replaces the designators with chars.
+func (d *timeDesignators) set(chars ...byte) {
+	*d = timeDesignators{}
+	d.add(chars...)
+}
+
+// add adds chars which are not in the designators yet.
+func (d *timeDesignators) add(chars ...byte) {
+	for _, c := range chars {
+		switch {
+		case d.contains(c):
+		case d.n < len(d.chars):
+			d.chars[d.n] = c
+			d.n++
+		default:
+			// Copy more so that the copies of the options do not share it.
+			d.more = append(d.more[:len(d.more):len(d.more)], c)
+		}
+	}
+}
+
+// contains reports whether c is a designator.
+func (d *timeDesignators) contains(c byte) bool {
+	return indexByte(d.chars[:d.n], c) >= 0 || indexByte(d.more, c) >= 0
+}
+
+// String returns the quoted designators separated by ", ".
+func (d *timeDesignators) String() string {
+	var buf strings.Builder
+	for i, c := range append(d.chars[:d.n:d.n], d.more...) {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%q", c)
+	}
+	return buf.String()
+}
+
 // WithTimeDesignators is an option that modifies the set of valid
 // characters which can be used as time designators when parsing a datetime string.
 //
 // By default, if no designators are set, the parser uses only 'T'.
 func WithTimeDesignators(designators ...byte) ParseDateTimeOptions {
 	return func(o *parseDateTimeOptions) {
-		o.timeDesignators = append(o.timeDesignators, designators...)
+		o.timeDesignators.add(designators...)
 	}
 }
 
@@ -39,6 +120,35 @@ func WithInLocation(loc *time.Location) ParseDateTimeOptions {
 	}
 }
 
+// WithoutTimeZone is an option to reject datetime strings which have
+// a time zone designator such as "Z" or "+09:00".
+//
+// This is useful to parse local (floating) date-times that are not bound to any zone.
+func WithoutTimeZone() ParseDateTimeOptions {
+	return func(o *parseDateTimeOptions) {
+		o.withoutZone = true
+	}
+}
+
+// WithExpandedYear is an option to parse the expanded representation of
+// years, which has a sign and the agreed number of extra digits in
+// addition to the 4 digits, such as "+012024-03-01" or "-000044-03-15"
+// for extra = 2. The sign is required and "-" denotes the years before
+// year 0 (1 BC). extra is clamped to [0,14].
+//
+// By default, extra is 0, which means that only 4-digit years with an
+// optional "+" sign are accepted.
+func WithExpandedYear(extra int) ParseDateTimeOptions {
+	if extra < 0 {
+		extra = 0
+	} else if extra > 14 {
+		extra = 14
+	}
+	return func(o *parseDateTimeOptions) {
+		o.expandedYear = extra
+	}
+}
+
 // ParseDateTime attempts to parse a given byte slice representing combined date, time,
 // and optionally timezone offset in supported ISO 8601 formats. Supported formats include:
 //
@@ -54,6 +164,11 @@ func WithInLocation(loc *time.Location) ParseDateTimeOptions {
 //	20070301T130045+0100         2007-03-01T13:00:45+01:00
 //	... and other combinations
 //
+// Use WithExpandedYear to parse years out of the range [0,9999] such as
+// "+012024-03-01T13:00Z".
+// Use WithProfile to restrict or relax the representations, such as
+// RFC3339Profile for the date-times of RFC 3339.
+//
 // The function returns a time.Time struct representing the parsed date-time, adjusted
 // for the parsed timezone offset if provided.
 //
@@ -65,46 +180,60 @@ func WithInLocation(loc *time.Location) ParseDateTimeOptions {
 // being in a fabricated location with time fixed at the given zone offset.
 //
 // If parsing fails, an error is returned.
+//
+// b is not copied, and no allocation happens on success.
 func ParseDateTime[bytes []byte | ~string](b bytes, opts ...ParseDateTimeOptions) (time.Time, error) {
-	return parseDateTime([]byte(b), opts...)
+	o := newParseDateTimeOptions(opts)
+	return parseDateTime(b, &o)
 }
 
-func parseDateTime(b []byte, opts ...ParseDateTimeOptions) (time.Time, error) {
-	o := new(parseDateTimeOptions)
-	*o = defaultParseDateTimeOptions // apply default options
-	for _, opt := range opts {
-		opt(o)
-	}
+// ParseDateTimeInLocation is like ParseDateTime but interprets the time
+// without a time zone designator as in the given location, in the same way
+// as WithInLocation(loc) followed by opts.
+func ParseDateTimeInLocation[bytes []byte | ~string](b bytes, loc *time.Location, opts ...ParseDateTimeOptions) (time.Time, error) {
+	base := defaultParseDateTimeOptions
+	base.local = loc
+	o := applyParseDateTimeOptions(base, opts)
+	return parseDateTime(b, &o)
+}
 
-	n, d, err := parseDate(b)
+func parseDateTime[bytes []byte | ~string](b bytes, o *parseDateTimeOptions) (time.Time, error) {
+	if o.profile == W3CDTFProfile {
+		if t, ok := parseReducedDate(b); ok {
+			return t, nil
+		}
+	}
+	n, d, err := parseDate(b, o.expandedYear)
 	if err != nil {
-		return time.Time{}, overrideUnexpectedTokenValue(err, b)
+		return time.Time{}, overrideErrorPosition(err, b, 0)
 	}
-	dt := d.Date()
+	if err := checkProfileDate(b, n, d, o.profile); err != nil {
+		return time.Time{}, err
+	}
+	dt := d.date()
 	if len(b) == n {
+		if o.profile == RFC3339Profile || o.profile == XMLSchemaProfile {
+			return time.Time{}, &UnexpectedTokenError{
+				Value:      string(b),
+				AfterToken: string(b),
+				Expected:   "time (" + o.profile.String() + ")",
+				Offset:     n,
+				Kind:       KindUnexpectedEnd,
+			}
+		}
 		return dt.StdTime(), nil
 	}
 
 	if len(b) > n {
-		var found bool
-		for _, designator := range o.timeDesignators {
-			if b[n] == designator {
-				found = true
-				break
-			}
-		}
-		if !found {
-			var buf strings.Builder
-			size := len(o.timeDesignators)
-			for _, designator := range o.timeDesignators[:size-1] {
-				fmt.Fprintf(&buf, "%q, ", designator)
-			}
-			fmt.Fprintf(&buf, "%q", o.timeDesignators[size-1])
+		if !o.timeDesignators.contains(b[n]) {
 			return time.Time{}, &UnexpectedTokenError{
 				Value:      string(b),
 				Token:      string(b[n]),
 				AfterToken: string(b[:n]),
-				Expected:   buf.String(),
+				Expected:   o.timeDesignators.String(),
+				Offset:     n,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			}
 		}
 	}
@@ -118,45 +247,119 @@ func parseDateTime(b []byte, opts ...ParseDateTimeOptions) (time.Time, error) {
 			Token:      string(b[n:]),
 			AfterToken: string(b[:n]),
 			Expected:   "time format is required after the 'T' designator",
+			Offset:     n,
+			Kind:       KindUnexpectedEnd,
 		}
 	}
 
 	nt, t, err := parseTime(b[n:])
 	if err != nil {
-		return time.Time{}, overrideUnexpectedTokenValue(err, b)
+		return time.Time{}, overrideErrorPosition(err, b, n)
+	}
+	if err := checkProfileTime(b, n, nt, t, o.profile); err != nil {
+		return time.Time{}, err
 	}
 	n += nt
 
 	result := time.Date(dt.Year, dt.Month, dt.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
 	// There is no offset. returns as UTC.
 	if len(b) == n {
+		if o.profile == RFC3339Profile || o.profile == W3CDTFProfile {
+			return time.Time{}, &UnexpectedTokenError{
+				Value:      string(b),
+				AfterToken: string(b),
+				Expected:   "time zone designator (" + o.profile.String() + ")",
+				Offset:     n,
+				Kind:       KindUnexpectedEnd,
+			}
+		}
 		return result.In(o.local), nil
 	}
+	if o.withoutZone {
+		return time.Time{}, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[n:]),
+			AfterToken: string(b[:n]),
+			Expected:   "no time zone designator",
+			Offset:     n,
+			Length:     len(b) - n,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	if isUTCSuffix(b[n:], o.profile) {
+		return inZone(result, 0, o), nil
+	}
 	if len(b) > n && !(b[n] == 'Z' || b[n] == '+' || b[n] == '-') {
 		return time.Time{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      string(b[n]),
 			AfterToken: string(b[:n]),
 			Expected:   "time zone format after time format",
+			Offset:     n,
+			Length:     1,
+			Kind:       KindUnexpectedToken,
 		}
 	}
 
 	zone, err := ParseZone(b[n:])
 	if err != nil {
-		return time.Time{}, overrideUnexpectedTokenValue(err, b)
+		return time.Time{}, overrideErrorPosition(err, b, n)
+	}
+	if err := checkProfileZone(b, n, zone, o.profile); err != nil {
+		return time.Time{}, err
 	}
-	zoneOffset := zone.Offset()
-	result = result.Add(-1 * time.Duration(zoneOffset) * time.Second)
+	return inZone(result, zone.Offset(), o), nil
+}
+
+// inZone returns the time whose wall clock in the zone offset is the wall
+// clock of t in UTC.
+func inZone(t time.Time, zoneOffset int, o *parseDateTimeOptions) time.Time {
+	t = t.Add(-1 * time.Duration(zoneOffset) * time.Second)
 
 	// Try to align this part with Go's time.Parse timezone handling as closely as possible.
 	// Use local zone with the given offset if possible.
-	localResult := result.In(o.local)
+	localResult := t.In(o.local)
 	_, offset := localResult.Zone()
 	if offset == zoneOffset {
-		result = localResult
-	} else {
-		result = result.In(time.FixedZone("", zoneOffset))
+		return localResult
 	}
+	return t.In(fixedZone(zoneOffset))
+}
 
-	return result, nil
+// fixedZones caches the fabricated locations for the zone offsets of
+// whole minutes to avoid allocating the same location for each value.
+// The map is copied on write so that it can be read without locking.
+var fixedZones struct {
+	mu sync.Mutex
+	m  atomic.Pointer[map[int]*time.Location]
+}
+
+// fixedZone returns the location with time fixed at the given zone offset.
+func fixedZone(offset int) *time.Location {
+	if offset%60 != 0 {
+		return time.FixedZone("", offset)
+	}
+	if m := fixedZones.m.Load(); m != nil {
+		if loc, ok := (*m)[offset]; ok {
+			return loc
+		}
+	}
+	fixedZones.mu.Lock()
+	defer fixedZones.mu.Unlock()
+	old := fixedZones.m.Load()
+	if old != nil {
+		if loc, ok := (*old)[offset]; ok {
+			return loc
+		}
+	}
+	m := make(map[int]*time.Location, 1)
+	if old != nil {
+		for k, v := range *old {
+			m[k] = v
+		}
+	}
+	loc := time.FixedZone("", offset)
+	m[offset] = loc
+	fixedZones.m.Store(&m)
+	return loc
 }
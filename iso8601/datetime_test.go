@@ -154,6 +154,7 @@ func TestParseDateTime(t *testing.T) {
 				Token:      humanizeDigits(0),
 				AfterToken: "",
 				Expected:   "date format",
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -163,6 +164,20 @@ func TestParseDateTime(t *testing.T) {
 				Token:      "",
 				AfterToken: "2017-04-24T",
 				Expected:   "time format is required after the 'T' designator",
+				Offset:     11,
+				Kind:       KindUnexpectedEnd,
+			},
+		},
+		{
+			name: "2017-04-24T09:41+09:00abc",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2017-04-24T09:41+09:00abc",
+				Token:      "abc",
+				AfterToken: "+09:00",
+				Expected:   "+09:00",
+				Offset:     22,
+				Length:     3,
+				Kind:       KindExtraText,
 			},
 		},
 		{
@@ -172,6 +187,9 @@ func TestParseDateTime(t *testing.T) {
 				Token:      "X",
 				AfterToken: "2017-04-24",
 				Expected:   "'T'",
+				Offset:     10,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -181,6 +199,8 @@ func TestParseDateTime(t *testing.T) {
 				Token:      humanizeDigits(0),
 				AfterToken: "09:41:",
 				Expected:   humanizeDigits(2),
+				Offset:     17,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -190,6 +210,9 @@ func TestParseDateTime(t *testing.T) {
 				Token:      "X",
 				AfterToken: "+2017-04-24T09:41:34.502",
 				Expected:   "time zone format after time format",
+				Offset:     24,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -199,6 +222,9 @@ func TestParseDateTime(t *testing.T) {
 				Token:      "12",
 				AfterToken: "Z",
 				Expected:   "non extra token (12)",
+				Offset:     20,
+				Length:     2,
+				Kind:       KindExtraText,
 			},
 		},
 	}
@@ -238,6 +264,9 @@ func TestParseDateTime(t *testing.T) {
 				Token:      "X",
 				AfterToken: "2017-04-24",
 				Expected:   `'T', ' '`,
+				Offset:     10,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			}
 			_, err := ParseDateTime("2017-04-24X09:41:34", WithTimeDesignators(' '))
 			if err == nil {
@@ -303,4 +332,213 @@ func TestParseDateTime(t *testing.T) {
 			}
 		})
 	})
+	t.Run("WithoutTimeZone", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			want := time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC)
+			got, err := ParseDateTime("2017-04-24T09:41:34", WithoutTimeZone(), WithInLocation(time.UTC))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			wantErr := &UnexpectedTokenError{
+				Value:      "2017-04-24T09:41:34Z",
+				Token:      "Z",
+				AfterToken: "2017-04-24T09:41:34",
+				Expected:   "no time zone designator",
+				Offset:     19,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
+			}
+			_, err := ParseDateTime("2017-04-24T09:41:34Z", WithoutTimeZone())
+			if diff := cmp.Diff(wantErr, err); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	})
+	t.Run("WithExpandedYear", func(t *testing.T) {
+		tests := []struct {
+			value string
+			want  time.Time
+		}{
+			{
+				value: "+012024-03-01T13:00Z",
+				want:  time.Date(12024, 3, 1, 13, 0, 0, 0, time.UTC),
+			},
+			{
+				value: "-000044-03-15T12:00:00+01:00",
+				want:  time.Date(-44, 3, 15, 11, 0, 0, 0, time.UTC),
+			},
+			{
+				value: "+0020240301T1300Z",
+				want:  time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC),
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.value, func(t *testing.T) {
+				got, err := ParseDateTime(tt.value, WithExpandedYear(2), WithInLocation(time.UTC))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !tt.want.Equal(got) {
+					t.Errorf("want %v but got %v", tt.want, got)
+				}
+			})
+		}
+
+		t.Run("invalid", func(t *testing.T) {
+			wantErr := &UnexpectedTokenError{
+				Value:    "2024-03-01T13:00Z",
+				Token:    "2",
+				Expected: "+ or - sign of the expanded year",
+				Length:   1,
+				Kind:     KindUnexpectedToken,
+			}
+			_, err := ParseDateTime("2024-03-01T13:00Z", WithExpandedYear(2))
+			if diff := cmp.Diff(wantErr, err); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	})
+}
+
+func TestParseDateTime_Allocs(t *testing.T) {
+	type myString string
+	value := []byte("2024-03-01T12:30:45.123456789-07:00")
+	tests := []struct {
+		name  string
+		parse func() error
+	}{
+		{
+			name: "string",
+			parse: func() error {
+				_, err := ParseDateTime("2024-03-01T12:30:45.123456789+09:00")
+				return err
+			},
+		},
+		{
+			name: "bytes",
+			parse: func() error {
+				_, err := ParseDateTime(value)
+				return err
+			},
+		},
+		{
+			name: "defined string type",
+			parse: func() error {
+				_, err := ParseDateTime(myString("20240301T123045Z"))
+				return err
+			},
+		},
+		{
+			name: "with profile",
+			parse: func() error {
+				_, err := ParseDateTime("2024-03-01t12:30:45Z", WithProfile(RFC3339Profile))
+				return err
+			},
+		},
+		{
+			name: "with options",
+			parse: func() error {
+				_, err := ParseDateTime("2024-03-01 12:30:45",
+					WithTimeDesignators(' '), WithInLocation(time.UTC), WithoutTimeZone())
+				return err
+			},
+		},
+		{
+			name: "in location",
+			parse: func() error {
+				_, err := ParseDateTimeInLocation(value, time.UTC, WithProfile(LenientProfile))
+				return err
+			},
+		},
+		{
+			name: "week date",
+			parse: func() error {
+				_, err := ParseDateTime("2024-W09-5T12:30+05:30")
+				return err
+			},
+		},
+		{
+			name: "time",
+			parse: func() error {
+				_, err := ParseTime("12:30:45,5")
+				return err
+			},
+		},
+		{
+			name: "zone",
+			parse: func() error {
+				_, err := ParseZone("-0330")
+				return err
+			},
+		},
+		{
+			name: "duration",
+			parse: func() error {
+				_, err := ParseDuration("P1Y2M3DT4H5M6.5S")
+				return err
+			},
+		},
+		{
+			name: "interval",
+			parse: func() error {
+				_, err := ParseInterval("2007-03-01T13:00:00Z/P1Y2M10DT2H30M")
+				return err
+			},
+		},
+		{
+			name: "repeating interval",
+			parse: func() error {
+				_, err := ParseRepeatingInterval("R5/2007-03-01T13:00:00Z/2008-05-11T15:30:00Z")
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.parse(); err != nil {
+				t.Fatal(err)
+			}
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = tt.parse()
+			})
+			if allocs != 0 {
+				t.Errorf("want no allocations but got %v", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkParseDateTime(b *testing.B) {
+	const value = "2024-03-01T12:30:45.123456789+09:00"
+	b.Run("iso8601.ParseDateTime string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseDateTime(value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("iso8601.ParseDateTime bytes", func(b *testing.B) {
+		v := []byte(value)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseDateTime(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("time.Parse RFC3339Nano", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
 }
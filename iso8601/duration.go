@@ -28,11 +28,12 @@ import (
 // or -P1M for interoperability, be aware that other programs may not recognize it.
 //
 // The function returns a Duration structure or an error if the parsing fails.
+// b is not copied, and no allocation happens on success.
 func ParseDuration[bytes []byte | ~string](b bytes) (Duration, error) {
-	return parseDuration([]byte(b))
+	return parseDuration(b)
 }
 
-func parseDuration(b []byte) (Duration, error) {
+func parseDuration[bytes []byte | ~string](b bytes) (Duration, error) {
 	var (
 		y                 int
 		m                 int
@@ -51,6 +52,7 @@ func parseDuration(b []byte) (Duration, error) {
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "P or + or -",
+			Kind:       KindUnexpectedEnd,
 		}
 	}
 
@@ -66,6 +68,8 @@ func parseDuration(b []byte) (Duration, error) {
 				Token:      string(b),
 				AfterToken: string(b[0]),
 				Expected:   "P",
+				Offset:     1,
+				Kind:       KindUnexpectedEnd,
 			}
 		}
 		i++
@@ -78,6 +82,8 @@ func parseDuration(b []byte) (Duration, error) {
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "P or + or -",
+			Length:     len(b),
+			Kind:       KindUnexpectedToken,
 		}
 	}
 
@@ -100,6 +106,9 @@ func parseDuration(b []byte) (Duration, error) {
 				Token:      string(b[idx]),
 				AfterToken: string(b[:idx]),
 				Expected:   "the designator to be used only once",
+				Offset:     idx,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			}
 		}
 		if err := setter(); err != nil {
@@ -116,6 +125,9 @@ func parseDuration(b []byte) (Duration, error) {
 					Token:      string(b[idx]),
 					AfterToken: string(b[:idx]),
 					Expected:   "date duration should put before the 'T'",
+					Offset:     idx,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
 			return f()
@@ -129,6 +141,9 @@ func parseDuration(b []byte) (Duration, error) {
 					Token:      string(b[idx]),
 					AfterToken: string(b[:idx]),
 					Expected:   "the 'T' designator is required",
+					Offset:     idx,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
 			return f()
@@ -145,6 +160,9 @@ func parseDuration(b []byte) (Duration, error) {
 						Token:      "T",
 						AfterToken: string(b[:i]),
 						Expected:   "the 'T' designator should be once",
+						Offset:     i,
+						Length:     1,
+						Kind:       KindUnexpectedToken,
 					}
 				}
 				i++
@@ -156,6 +174,9 @@ func parseDuration(b []byte) (Duration, error) {
 				Token:      string(b[i]),
 				AfterToken: string(b[:i]),
 				Expected:   "PnYnMnDTnHnMnS or PnW format",
+				Offset:     i,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			}
 		}
 
@@ -172,6 +193,9 @@ func parseDuration(b []byte) (Duration, error) {
 					Token:      string(b[i]),
 					AfterToken: string(b[:i]),
 					Expected:   "only the time unit can be fractional",
+					Offset:     i,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
 			if seenFranction {
@@ -180,6 +204,9 @@ func parseDuration(b []byte) (Duration, error) {
 					Token:      string(b[i]),
 					AfterToken: string(b[:i]),
 					Expected:   "only the smallest time unit can be fractional",
+					Offset:     i,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
 			i++
@@ -201,6 +228,9 @@ func parseDuration(b []byte) (Duration, error) {
 								Token:      "Y",
 								AfterToken: string(b[:i]),
 								Expected:   fmt.Sprintf("the 'Y' date designator should appear before '%s'", string(designator)),
+								Offset:     i,
+								Length:     1,
+								Kind:       KindUnexpectedToken,
 							}
 						}
 					}
@@ -220,6 +250,9 @@ func parseDuration(b []byte) (Duration, error) {
 								Token:      "M",
 								AfterToken: string(b[:i]),
 								Expected:   "the 'M' time designator should appear before 'S'",
+								Offset:     i,
+								Length:     1,
+								Kind:       KindUnexpectedToken,
 							}
 						}
 						minute = val
@@ -241,6 +274,9 @@ func parseDuration(b []byte) (Duration, error) {
 									Token:      "M",
 									AfterToken: string(b[:i]),
 									Expected:   fmt.Sprintf("the 'M' date designator should appear before '%s'", string(designator)),
+									Offset:     i,
+									Length:     1,
+									Kind:       KindUnexpectedToken,
 								}
 							}
 						}
@@ -259,6 +295,9 @@ func parseDuration(b []byte) (Duration, error) {
 							Token:      "W",
 							AfterToken: string(b[:i]),
 							Expected:   "the 'W' date designator should appear before 'D'",
+							Offset:     i,
+							Length:     1,
+							Kind:       KindUnexpectedToken,
 						}
 					}
 					w = val
@@ -284,6 +323,9 @@ func parseDuration(b []byte) (Duration, error) {
 								Token:      "H",
 								AfterToken: string(b[:i]),
 								Expected:   fmt.Sprintf("the 'H' time designator should appear before '%s'", string(designator)),
+								Offset:     i,
+								Length:     1,
+								Kind:       KindUnexpectedToken,
 							}
 						}
 					}
@@ -313,6 +355,9 @@ func parseDuration(b []byte) (Duration, error) {
 					Token:      string(b[i]),
 					AfterToken: string(b[:i]),
 					Expected:   "PnYnMnDTnHnMnS or PnW format",
+					Offset:     i,
+					Length:     1,
+					Kind:       KindUnexpectedToken,
 				}
 			}
 			i++
@@ -927,6 +927,7 @@ func TestParseDuration(t *testing.T) {
 				Token:      "",
 				AfterToken: "",
 				Expected:   "P or + or -",
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -936,6 +937,8 @@ func TestParseDuration(t *testing.T) {
 				Token:      "X",
 				AfterToken: "",
 				Expected:   "P or + or -",
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -945,6 +948,8 @@ func TestParseDuration(t *testing.T) {
 				Token:      "+",
 				AfterToken: "+",
 				Expected:   "P",
+				Offset:     1,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -954,6 +959,8 @@ func TestParseDuration(t *testing.T) {
 				Token:      "-",
 				AfterToken: "-",
 				Expected:   "P",
+				Offset:     1,
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -963,6 +970,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "Y",
 				AfterToken: "P1Y1",
 				Expected:   "the designator to be used only once",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -972,6 +982,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "Y",
 				AfterToken: "P1Y2M1",
 				Expected:   "the designator to be used only once",
+				Offset:     6,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -981,6 +994,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "Y",
 				AfterToken: "P1M1",
 				Expected:   "the 'Y' date designator should appear before 'M'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -990,6 +1006,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "Y",
 				AfterToken: "P1W1",
 				Expected:   "the 'Y' date designator should appear before 'W'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -999,6 +1018,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "Y",
 				AfterToken: "P1D1",
 				Expected:   "the 'Y' date designator should appear before 'D'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1008,6 +1030,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "M",
 				AfterToken: "P1W1",
 				Expected:   "the 'M' date designator should appear before 'W'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1017,6 +1042,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "M",
 				AfterToken: "P1D1",
 				Expected:   "the 'M' date designator should appear before 'D'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1026,6 +1054,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "W",
 				AfterToken: "P1D1",
 				Expected:   "the 'W' date designator should appear before 'D'",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1035,6 +1066,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "D",
 				AfterToken: "PT1",
 				Expected:   "date duration should put before the 'T'",
+				Offset:     3,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1044,6 +1078,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "S",
 				AfterToken: "P1",
 				Expected:   "the 'T' designator is required",
+				Offset:     2,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1053,6 +1090,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "M",
 				AfterToken: "PT1S1",
 				Expected:   "the 'M' time designator should appear before 'S'",
+				Offset:     5,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1062,6 +1102,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "H",
 				AfterToken: "PT1S1",
 				Expected:   "the 'H' time designator should appear before 'S'",
+				Offset:     5,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1071,6 +1114,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "H",
 				AfterToken: "PT1M1",
 				Expected:   "the 'H' time designator should appear before 'M'",
+				Offset:     5,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1080,6 +1126,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "X",
 				AfterToken: "P1",
 				Expected:   "PnYnMnDTnHnMnS or PnW format",
+				Offset:     2,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1089,6 +1138,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "T",
 				AfterToken: "PT",
 				Expected:   "the 'T' designator should be once",
+				Offset:     2,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1098,6 +1150,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      "N",
 				AfterToken: "PT",
 				Expected:   "PnYnMnDTnHnMnS or PnW format",
+				Offset:     2,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		// unexpected fraction
@@ -1108,6 +1163,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      ".",
 				AfterToken: "P1",
 				Expected:   "only the time unit can be fractional",
+				Offset:     2,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1117,6 +1175,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      ".",
 				AfterToken: "P12",
 				Expected:   "only the time unit can be fractional",
+				Offset:     3,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1126,6 +1187,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      ",",
 				AfterToken: "P123",
 				Expected:   "only the time unit can be fractional",
+				Offset:     4,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -1135,6 +1199,9 @@ func TestParseDuration(t *testing.T) {
 				Token:      ".",
 				AfterToken: "PT123,123H123",
 				Expected:   "only the smallest time unit can be fractional",
+				Offset:     13,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 	}
@@ -6,18 +6,53 @@ import (
 	"strings"
 )
 
+// ErrorKind represents a machine-readable kind of parse errors.
+// The values are stable and can be used for comparison and serialization.
+type ErrorKind string
+
+const (
+	// KindUnexpectedToken indicates that a token does not match the format.
+	KindUnexpectedToken ErrorKind = "unexpected_token"
+	// KindUnexpectedEnd indicates that the value ends before the format is completed.
+	KindUnexpectedEnd ErrorKind = "unexpected_end"
+	// KindExtraText indicates that the value has extra text after the format.
+	KindExtraText ErrorKind = "extra_text"
+	// KindOutOfRange indicates that a component such as month is out of the range.
+	KindOutOfRange ErrorKind = "out_of_range"
+)
+
+// PositionError is implemented by the errors returned by the parsing functions
+// such as ParseDate, ParseTime, ParseZone, ParseDuration and ParseDateTime.
+// It provides the position of the failing token in the parsed value.
+type PositionError interface {
+	error
+
+	// Position returns the byte offset and the length of the failing token
+	// in the parsed value. The length is zero if the value ends at the offset.
+	Position() (offset, length int)
+
+	// ErrorKind returns the kind of the error.
+	ErrorKind() ErrorKind
+}
+
 // UnexpectedTokenError represents an error encountered when an unexpected
 // token is detected during parsing. This error provides details about
 // the token that was unexpected, any preceding token, and what was
 // expected in its place.
+//
+// Offset and Length are the position of the token in Value in bytes.
 type UnexpectedTokenError struct {
 	Value      string
 	Token      string
 	AfterToken string
 	Expected   string
+
+	Offset int
+	Length int
+	Kind   ErrorKind
 }
 
-var _ error = (*UnexpectedTokenError)(nil)
+var _ PositionError = (*UnexpectedTokenError)(nil)
 
 // Error implements the error interface.
 func (u *UnexpectedTokenError) Error() string {
@@ -33,11 +68,92 @@ func (u *UnexpectedTokenError) Error() string {
 	return buf.String()
 }
 
-func overrideUnexpectedTokenValue(err error, b []byte) error {
+// Position implements the PositionError interface.
+func (u *UnexpectedTokenError) Position() (offset, length int) {
+	return u.Offset, u.Length
+}
+
+// ErrorKind implements the PositionError interface.
+func (u *UnexpectedTokenError) ErrorKind() ErrorKind {
+	return u.Kind
+}
+
+// ErrorCaret returns the message of err followed by value and the caret line
+// which points the failing token. For example:
+//
+//	iso8601: 13 month is not in range 1-12 in 2024
+//	2024-13-01
+//	     ^^
+//
+// value must be the value passed to the parsing function. If err does not
+// implement PositionError, only the message of err is returned.
+func ErrorCaret(value string, err error) string {
+	var perr PositionError
+	if !errors.As(err, &perr) {
+		return err.Error()
+	}
+	offset, length := perr.Position()
+	if offset > len(value) {
+		offset = len(value)
+	}
+	if length < 1 {
+		length = 1
+	}
+	var buf strings.Builder
+	buf.WriteString(err.Error())
+	buf.WriteByte('\n')
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+	for _, r := range value[:offset] {
+		if r == '\t' {
+			buf.WriteByte('\t')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	buf.WriteString(strings.Repeat("^", length))
+	return buf.String()
+}
+
+// tokenLength returns the length of the token which starts at i and has n
+// digits. If the token has no digits, it is the unexpected character.
+func tokenLength[bytes []byte | ~string](b bytes, i, n int) int {
+	if n > 0 || i >= len(b) {
+		return n
+	}
+	return 1
+}
+
+// tokenKind returns KindUnexpectedEnd if b ends at i, otherwise KindUnexpectedToken.
+func tokenKind[bytes []byte | ~string](b bytes, i int) ErrorKind {
+	if i >= len(b) {
+		return KindUnexpectedEnd
+	}
+	return KindUnexpectedToken
+}
+
+// overrideErrorPosition replaces the value of the error with b and shifts
+// the position by offset, where the error occurred in b[offset:].
+func overrideErrorPosition[bytes []byte | ~string](err error, b bytes, offset int) error {
 	var unexpected *UnexpectedTokenError
 	if errors.As(err, &unexpected) {
 		unexpected.Value = string(b)
-		err = unexpected
+		unexpected.Offset += offset
+		return err
+	}
+	var dateRange *DateLikeRangeError
+	if errors.As(err, &dateRange) {
+		dateRange.Offset += offset
+		return err
+	}
+	var timeRange *TimeRangeError
+	if errors.As(err, &timeRange) {
+		timeRange.Offset += offset
+		return err
+	}
+	var zoneRange *TimeZoneRangeError
+	if errors.As(err, &zoneRange) {
+		zoneRange.Offset += offset
 	}
 	return err
 }
@@ -1,6 +1,9 @@
 package iso8601
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestUnexpectedTokenError_Error(t *testing.T) {
 	tests := []struct {
@@ -40,3 +43,177 @@ func TestUnexpectedTokenError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorCaret(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		parse func(string) error
+		want  string
+	}{
+		{
+			name:  "out of range",
+			value: "2024-13-01",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			want: "iso8601: 13 month is not in range 1-12 in 2024\n" +
+				"2024-13-01\n" +
+				"     ^^",
+		},
+		{
+			name:  "unexpected token",
+			value: "2024-01-01T10:00:00+09:X0",
+			parse: func(s string) error { _, err := ParseDateTime(s); return err },
+			want: `unexpected token "0-digit" after "+09:" expected "2-digits" ("2024-01-01T10:00:00+09:X0")` + "\n" +
+				"2024-01-01T10:00:00+09:X0\n" +
+				"                       ^",
+		},
+		{
+			name:  "unexpected end",
+			value: "2024-01-01T",
+			parse: func(s string) error { _, err := ParseDateTime(s); return err },
+			want: `unexpected token "" after "2024-01-01T" expected "time format is required after the 'T' designator" ("2024-01-01T")` + "\n" +
+				"2024-01-01T\n" +
+				"           ^",
+		},
+		{
+			name:  "range error in time",
+			value: "2024-01-01T23:60",
+			parse: func(s string) error { _, err := ParseDateTime(s); return err },
+			want: "iso8601 time: 60 minute is not in range 0-59\n" +
+				"2024-01-01T23:60\n" +
+				"              ^^",
+		},
+		{
+			name:  "tab is kept",
+			value: "\t2024",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			want: `unexpected token "0-digit" expected "date format" ("\t2024")` + "\n" +
+				"\t2024\n" +
+				"^",
+		},
+		{
+			name:  "no position",
+			value: "2024-01-01",
+			parse: func(string) error { return errors.New("oops") },
+			want:  "oops",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorCaret(tt.value, tt.parse(tt.value))
+			if got != tt.want {
+				t.Errorf("want\n%s\nbut got\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPositionError(t *testing.T) {
+	tests := []struct {
+		name       string
+		parse      func() error
+		wantOffset int
+		wantLength int
+		wantKind   ErrorKind
+	}{
+		{
+			name:       "ParseDate",
+			parse:      func() error { _, err := ParseDate("2024-W1-12"); return err },
+			wantOffset: 6,
+			wantLength: 1,
+			wantKind:   KindUnexpectedToken,
+		},
+		{
+			name:       "ParseDate with sign",
+			parse:      func() error { _, err := ParseDate("+2024-02-30"); return err },
+			wantOffset: 9,
+			wantLength: 2,
+			wantKind:   KindOutOfRange,
+		},
+		{
+			name:       "ParseDate with extra text",
+			parse:      func() error { _, err := ParseDate("2024-02-03T"); return err },
+			wantOffset: 10,
+			wantLength: 1,
+			wantKind:   KindExtraText,
+		},
+		{
+			name:       "ParseTime",
+			parse:      func() error { _, err := ParseTime("12:3"); return err },
+			wantOffset: 3,
+			wantLength: 1,
+			wantKind:   KindUnexpectedToken,
+		},
+		{
+			name:       "ParseZone",
+			parse:      func() error { _, err := ParseZone("+09:"); return err },
+			wantOffset: 4,
+			wantLength: 0,
+			wantKind:   KindUnexpectedEnd,
+		},
+		{
+			name:       "ParseDuration",
+			parse:      func() error { _, err := ParseDuration("P1DT2H3D"); return err },
+			wantOffset: 7,
+			wantLength: 1,
+			wantKind:   KindUnexpectedToken,
+		},
+		{
+			name:       "ParseDateTime",
+			parse:      func() error { _, err := ParseDateTime("2024-02-03T25:00Z"); return err },
+			wantOffset: 11,
+			wantLength: 2,
+			wantKind:   KindOutOfRange,
+		},
+		{
+			name:       "ParseDateTime zone",
+			parse:      func() error { _, err := ParseDateTime("2024-02-03T12:00+0"); return err },
+			wantOffset: 17,
+			wantLength: 1,
+			wantKind:   KindUnexpectedToken,
+		},
+		{
+			name:       "ParseInterval end",
+			parse:      func() error { _, err := ParseInterval("2024-02-03/2024-02-30"); return err },
+			wantOffset: 19,
+			wantLength: 2,
+			wantKind:   KindOutOfRange,
+		},
+		{
+			name:       "ParseInterval completed end",
+			parse:      func() error { _, err := ParseInterval("2024-02-03/30"); return err },
+			wantOffset: 11,
+			wantLength: 2,
+			wantKind:   KindOutOfRange,
+		},
+		{
+			name:       "ParseRepeatingInterval",
+			parse:      func() error { _, err := ParseRepeatingInterval("R2/P1D/P"); return err },
+			wantOffset: 7,
+			wantLength: 1,
+			wantKind:   KindUnexpectedToken,
+		},
+		{
+			name:       "ParsePartialDate",
+			parse:      func() error { _, err := ParsePartialDate("2024-W54"); return err },
+			wantOffset: 6,
+			wantLength: 2,
+			wantKind:   KindOutOfRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var perr PositionError
+			if !errors.As(tt.parse(), &perr) {
+				t.Fatal("want PositionError")
+			}
+			offset, length := perr.Position()
+			if offset != tt.wantOffset || length != tt.wantLength {
+				t.Errorf("want position (%d, %d) but got (%d, %d)", tt.wantOffset, tt.wantLength, offset, length)
+			}
+			if got := perr.ErrorKind(); got != tt.wantKind {
+				t.Errorf("want kind %q but got %q", tt.wantKind, got)
+			}
+		})
+	}
+}
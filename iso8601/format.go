@@ -0,0 +1,390 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// DateForm represents the representation of dates used by Format.
+type DateForm int
+
+const (
+	// CalendarDateForm formats dates as calendar dates, such as "2012-12-24".
+	CalendarDateForm DateForm = iota
+	// OrdinalDateForm formats dates as ordinal dates, such as "2012-359".
+	OrdinalDateForm
+	// WeekDateForm formats dates as week dates, such as "2012-W52-1".
+	WeekDateForm
+	// QuarterDateForm formats dates as quarter dates, such as "2012-Q4-85".
+	QuarterDateForm
+)
+
+// Precision represents the lowest-order component written by Format.
+type Precision int
+
+const (
+	// PrecisionSecond writes the time up to seconds and the fraction, such as "12:30:45.5".
+	PrecisionSecond Precision = iota
+	// PrecisionMinute writes the time up to minutes, such as "12:30".
+	PrecisionMinute
+	// PrecisionHour writes the time up to hours, such as "12".
+	PrecisionHour
+	// PrecisionDay writes only the date, such as "2012-12-24".
+	PrecisionDay
+)
+
+type formatOptions struct {
+	basic          bool
+	form           DateForm
+	precision      Precision
+	fractionDigits int // -1 means as many digits as needed.
+	numericUTC     bool
+	withoutZone    bool
+	expandedYear   int
+}
+
+// FormatOptions is a function type that modifies the formatting behavior
+// of Format. It acts as a functional option.
+type FormatOptions func(*formatOptions)
+
+// WithBasicFormat is an option to use the basic format, which has no
+// separators such as "20121224T123045Z".
+//
+// By default, the extended format such as "2012-12-24T12:30:45Z" is used.
+func WithBasicFormat() FormatOptions {
+	return func(o *formatOptions) {
+		o.basic = true
+	}
+}
+
+// WithDateForm is an option to change the representation of dates.
+//
+// By default, Format uses CalendarDateForm, and the Format methods of
+// the DateLike types use the representation of their own.
+func WithDateForm(form DateForm) FormatOptions {
+	return func(o *formatOptions) {
+		o.form = form
+	}
+}
+
+// WithPrecision is an option to reduce the precision of the time.
+// The lower-order components are truncated.
+//
+// By default, PrecisionSecond is used.
+func WithPrecision(p Precision) FormatOptions {
+	return func(o *formatOptions) {
+		o.precision = p
+	}
+}
+
+// WithFractionDigits is an option to write the fraction of a second in
+// exactly n digits. The fraction is truncated and n is clamped to [0,9].
+//
+// By default, the fraction is written with as many digits as needed,
+// and is omitted if it is zero.
+func WithFractionDigits(n int) FormatOptions {
+	if n < 0 {
+		n = 0
+	} else if n > 9 {
+		n = 9
+	}
+	return func(o *formatOptions) {
+		o.fractionDigits = n
+	}
+}
+
+// WithNumericUTC is an option to write the UTC offset of zero as "+00:00"
+// instead of "Z".
+func WithNumericUTC() FormatOptions {
+	return func(o *formatOptions) {
+		o.numericUTC = true
+	}
+}
+
+// WithoutZoneDesignator is an option to omit the time zone designator,
+// which writes the local time. Use WithoutTimeZone to parse it.
+func WithoutZoneDesignator() FormatOptions {
+	return func(o *formatOptions) {
+		o.withoutZone = true
+	}
+}
+
+// WithExpandedYearFormat is an option to write years in the expanded
+// representation, which has a sign and the agreed number of extra digits
+// in addition to the 4 digits, such as "+012024-03-01" or "-000044-03-15"
+// for extra = 2. extra is clamped to [0,14]. Use WithExpandedYear with the
+// same number to parse it.
+//
+// By default, extra is 0, which means that years are written in 4 digits
+// without any sign.
+func WithExpandedYearFormat(extra int) FormatOptions {
+	if extra < 0 {
+		extra = 0
+	} else if extra > 14 {
+		extra = 14
+	}
+	return func(o *formatOptions) {
+		o.expandedYear = extra
+	}
+}
+
+// newFormatOptions returns the options modified by opts.
+// It does not allocate if opts is empty.
+func newFormatOptions(form DateForm, opts []FormatOptions) formatOptions {
+	if len(opts) == 0 {
+		return formatOptions{
+			form:           form,
+			fractionDigits: -1,
+		}
+	}
+	o := &formatOptions{
+		form:           form,
+		fractionDigits: -1,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
+
+// Format returns the ISO 8601 representation of t. By default, it is the
+// extended format of the calendar date and the time with the time zone
+// designator, such as "2012-12-24T12:30:45.5+09:00", which is compatible
+// with RFC 3339. Use FormatOptions to change the representation.
+//
+// The result can be parsed by ParseDateTime into the same time if the year
+// of t (or the week-based year for WeekDateForm) is in the range [0,9999]
+// and the precision is not reduced. Use WithExpandedYearFormat for the
+// other years.
+// The UTC offset is written with seconds, such as "+09:18:59", only if the
+// offset is not a whole minute.
+func Format(t time.Time, opts ...FormatOptions) string {
+	var buf [64]byte
+	return string(AppendFormat(buf[:0], t, opts...))
+}
+
+// AppendFormat is like Format but appends the representation to b and
+// returns the extended buffer. It does not allocate without options
+// if b has enough capacity.
+func AppendFormat(b []byte, t time.Time, opts ...FormatOptions) []byte {
+	o := newFormatOptions(CalendarDateForm, opts)
+	b = appendDate(b, t.Year(), t.Month(), t.Day(), &o)
+	if o.precision == PrecisionDay {
+		return b
+	}
+	b = append(b, 'T')
+	b = appendTime(b, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), &o)
+	if !o.withoutZone {
+		_, offset := t.Zone()
+		b = appendZone(b, offset, &o)
+	}
+	return b
+}
+
+// Format returns the ISO 8601 representation of d. By default, it is the
+// extended format such as "2012-12-24". Only WithBasicFormat, WithDateForm and
+// WithExpandedYearFormat affect the result.
+func (d Date) Format(opts ...FormatOptions) string {
+	var buf [32]byte
+	return string(d.AppendFormat(buf[:0], opts...))
+}
+
+// AppendFormat is like Format but appends the representation to b and
+// returns the extended buffer.
+func (d Date) AppendFormat(b []byte, opts ...FormatOptions) []byte {
+	o := newFormatOptions(CalendarDateForm, opts)
+	return appendDate(b, d.Year, d.Month, d.Day, &o)
+}
+
+// Format returns the ISO 8601 representation of q. By default, it is the
+// extended format such as "2012-Q4-85". Only WithBasicFormat, WithDateForm and
+// WithExpandedYearFormat affect the result.
+func (q QuarterDate) Format(opts ...FormatOptions) string {
+	var buf [32]byte
+	return string(q.AppendFormat(buf[:0], opts...))
+}
+
+// AppendFormat is like Format but appends the representation to b and
+// returns the extended buffer.
+func (q QuarterDate) AppendFormat(b []byte, opts ...FormatOptions) []byte {
+	o := newFormatOptions(QuarterDateForm, opts)
+	d := q.Date()
+	return appendDate(b, d.Year, d.Month, d.Day, &o)
+}
+
+// Format returns the ISO 8601 representation of w. By default, it is the
+// extended format such as "2012-W52-1". Only WithBasicFormat, WithDateForm and
+// WithExpandedYearFormat affect the result.
+func (w WeekDate) Format(opts ...FormatOptions) string {
+	var buf [32]byte
+	return string(w.AppendFormat(buf[:0], opts...))
+}
+
+// AppendFormat is like Format but appends the representation to b and
+// returns the extended buffer.
+func (w WeekDate) AppendFormat(b []byte, opts ...FormatOptions) []byte {
+	o := newFormatOptions(WeekDateForm, opts)
+	d := w.Date()
+	return appendDate(b, d.Year, d.Month, d.Day, &o)
+}
+
+// Format returns the ISO 8601 representation of od. By default, it is the
+// extended format such as "2012-359". Only WithBasicFormat, WithDateForm and
+// WithExpandedYearFormat affect the result.
+func (od OrdinalDate) Format(opts ...FormatOptions) string {
+	var buf [32]byte
+	return string(od.AppendFormat(buf[:0], opts...))
+}
+
+// AppendFormat is like Format but appends the representation to b and
+// returns the extended buffer.
+func (od OrdinalDate) AppendFormat(b []byte, opts ...FormatOptions) []byte {
+	o := newFormatOptions(OrdinalDateForm, opts)
+	d := od.Date()
+	return appendDate(b, d.Year, d.Month, d.Day, &o)
+}
+
+func appendDate(b []byte, year int, month time.Month, day int, o *formatOptions) []byte {
+	sep := func(b []byte) []byte {
+		if o.basic {
+			return b
+		}
+		return append(b, '-')
+	}
+	switch o.form {
+	case OrdinalDateForm:
+		b = appendYear(b, year, o)
+		b = sep(b)
+		return appendInt(b, yearDay(year, month, day), 3)
+	case WeekDateForm:
+		tm := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		isoYear, week := tm.ISOWeek()
+		b = appendYear(b, isoYear, o)
+		b = sep(b)
+		b = append(b, 'W')
+		b = appendInt(b, week, 2)
+		b = sep(b)
+		return appendInt(b, (int(tm.Weekday())+6)%7+1, 1)
+	case QuarterDateForm:
+		quarter := (int(month)-1)/3 + 1
+		days := yearDay(year, month, day)
+		for q := 1; q < quarter; q++ {
+			days -= daysInQuarter(year, q)
+		}
+		b = appendYear(b, year, o)
+		b = sep(b)
+		b = append(b, 'Q')
+		b = appendInt(b, quarter, 1)
+		b = sep(b)
+		return appendInt(b, days, 2)
+	}
+	b = appendYear(b, year, o)
+	b = sep(b)
+	b = appendInt(b, int(month), 2)
+	b = sep(b)
+	return appendInt(b, day, 2)
+}
+
+// appendYear appends the year in 4 digits, or in the expanded representation
+// with the sign if it is specified by the options.
+func appendYear(b []byte, year int, o *formatOptions) []byte {
+	if o.expandedYear == 0 {
+		return appendInt(b, year, 4)
+	}
+	if year >= 0 {
+		b = append(b, '+')
+	}
+	return appendInt(b, year, 4+o.expandedYear)
+}
+
+func yearDay(year int, month time.Month, day int) int {
+	for m := 1; m < int(month); m++ {
+		day += daysInMonth(year, m)
+	}
+	return day
+}
+
+func appendTime(b []byte, hour, min, sec, nsec int, o *formatOptions) []byte {
+	b = appendInt(b, hour, 2)
+	if o.precision == PrecisionHour {
+		return b
+	}
+	if !o.basic {
+		b = append(b, ':')
+	}
+	b = appendInt(b, min, 2)
+	if o.precision == PrecisionMinute {
+		return b
+	}
+	if !o.basic {
+		b = append(b, ':')
+	}
+	b = appendInt(b, sec, 2)
+	return appendFraction(b, nsec, o.fractionDigits)
+}
+
+// appendFraction appends the fraction of a second in the given digits.
+// If digits is negative, the trailing zeros are removed.
+func appendFraction(b []byte, nsec, digits int) []byte {
+	if digits == 0 || (digits < 0 && nsec == 0) {
+		return b
+	}
+	var buf [9]byte
+	for i := len(buf) - 1; i >= 0; i-- {
+		buf[i] = byte(nsec%10) + '0'
+		nsec /= 10
+	}
+	if digits < 0 {
+		digits = len(buf)
+		for digits > 0 && buf[digits-1] == '0' {
+			digits--
+		}
+	}
+	b = append(b, '.')
+	return append(b, buf[:digits]...)
+}
+
+func appendZone(b []byte, offset int, o *formatOptions) []byte {
+	if offset == 0 && !o.numericUTC {
+		return append(b, 'Z')
+	}
+	if offset < 0 {
+		b = append(b, '-')
+		offset = -offset
+	} else {
+		b = append(b, '+')
+	}
+	b = appendInt(b, offset/3600, 2)
+	if !o.basic {
+		b = append(b, ':')
+	}
+	b = appendInt(b, offset/60%60, 2)
+	if sec := offset % 60; sec != 0 {
+		if !o.basic {
+			b = append(b, ':')
+		}
+		b = appendInt(b, sec, 2)
+	}
+	return b
+}
+
+// appendInt appends the decimal representation of n which is padded with
+// zeros to the width.
+func appendInt(b []byte, n, width int) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	var buf [20]byte
+	i := len(buf)
+	for n >= 10 {
+		i--
+		buf[i] = byte(n%10) + '0'
+		n /= 10
+	}
+	i--
+	buf[i] = byte(n) + '0'
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, '0')
+	}
+	return append(b, buf[i:]...)
+}
@@ -0,0 +1,215 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormat(t *testing.T) {
+	jst := time.FixedZone("JST", 9*3600)
+	tm := time.Date(2012, 12, 24, 9, 5, 7, 500000000, jst)
+	tests := []struct {
+		name string
+		t    time.Time
+		opts []FormatOptions
+		want string
+	}{
+		{name: "default", t: tm, want: "2012-12-24T09:05:07.5+09:00"},
+		{name: "basic", t: tm, opts: []FormatOptions{WithBasicFormat()}, want: "20121224T090507.5+0900"},
+		{name: "ordinal", t: tm, opts: []FormatOptions{WithDateForm(OrdinalDateForm)}, want: "2012-359T09:05:07.5+09:00"},
+		{name: "ordinal basic", t: tm, opts: []FormatOptions{WithDateForm(OrdinalDateForm), WithBasicFormat()}, want: "2012359T090507.5+0900"},
+		{name: "week", t: tm, opts: []FormatOptions{WithDateForm(WeekDateForm)}, want: "2012-W52-1T09:05:07.5+09:00"},
+		{name: "week basic", t: tm, opts: []FormatOptions{WithDateForm(WeekDateForm), WithBasicFormat()}, want: "2012W521T090507.5+0900"},
+		{name: "quarter", t: tm, opts: []FormatOptions{WithDateForm(QuarterDateForm)}, want: "2012-Q4-85T09:05:07.5+09:00"},
+		{name: "quarter basic", t: tm, opts: []FormatOptions{WithDateForm(QuarterDateForm), WithBasicFormat()}, want: "2012Q485T090507.5+0900"},
+		{name: "fraction digits", t: tm, opts: []FormatOptions{WithFractionDigits(3)}, want: "2012-12-24T09:05:07.500+09:00"},
+		{name: "no fraction", t: tm, opts: []FormatOptions{WithFractionDigits(0)}, want: "2012-12-24T09:05:07+09:00"},
+		{name: "too many fraction digits", t: tm, opts: []FormatOptions{WithFractionDigits(12)}, want: "2012-12-24T09:05:07.500000000+09:00"},
+		{name: "negative fraction digits", t: tm, opts: []FormatOptions{WithFractionDigits(-1)}, want: "2012-12-24T09:05:07+09:00"},
+		{name: "minute", t: tm, opts: []FormatOptions{WithPrecision(PrecisionMinute)}, want: "2012-12-24T09:05+09:00"},
+		{name: "minute basic", t: tm, opts: []FormatOptions{WithPrecision(PrecisionMinute), WithBasicFormat()}, want: "20121224T0905+0900"},
+		{name: "hour", t: tm, opts: []FormatOptions{WithPrecision(PrecisionHour)}, want: "2012-12-24T09+09:00"},
+		{name: "day", t: tm, opts: []FormatOptions{WithPrecision(PrecisionDay)}, want: "2012-12-24"},
+		{name: "without zone", t: tm, opts: []FormatOptions{WithoutZoneDesignator()}, want: "2012-12-24T09:05:07.5"},
+		{name: "utc", t: tm.UTC(), want: "2012-12-24T00:05:07.5Z"},
+		{name: "numeric utc", t: tm.UTC(), opts: []FormatOptions{WithNumericUTC()}, want: "2012-12-24T00:05:07.5+00:00"},
+		{name: "negative offset", t: tm.In(time.FixedZone("", -(3*3600 + 30*60))), want: "2012-12-23T20:35:07.5-03:30"},
+		{name: "offset with seconds", t: tm.In(time.FixedZone("LMT", 9*3600+18*60+59)), want: "2012-12-24T09:24:06.5+09:18:59"},
+		{name: "offset with seconds basic", t: tm.In(time.FixedZone("LMT", 9*3600+18*60+59)), opts: []FormatOptions{WithBasicFormat()}, want: "20121224T092406.5+091859"},
+		{name: "nanoseconds", t: time.Date(2000, 1, 1, 0, 0, 0, 1, time.UTC), want: "2000-01-01T00:00:00.000000001Z"},
+		{name: "year 0", t: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), want: "0000-01-01T00:00:00Z"},
+		{name: "week of previous year", t: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), opts: []FormatOptions{WithDateForm(WeekDateForm), WithPrecision(PrecisionDay)}, want: "2020-W53-7"},
+		{name: "expanded year", t: time.Date(12024, 3, 1, 13, 0, 0, 0, time.UTC), opts: []FormatOptions{WithExpandedYearFormat(2)}, want: "+012024-03-01T13:00:00Z"},
+		{name: "expanded negative year", t: time.Date(-44, 3, 15, 0, 0, 0, 0, time.UTC), opts: []FormatOptions{WithExpandedYearFormat(2), WithPrecision(PrecisionDay)}, want: "-000044-03-15"},
+		{name: "expanded year basic", t: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), opts: []FormatOptions{WithExpandedYearFormat(1), WithBasicFormat(), WithPrecision(PrecisionDay)}, want: "+020240301"},
+		{name: "expanded week-based year", t: time.Date(-1, 12, 31, 0, 0, 0, 0, time.UTC), opts: []FormatOptions{WithExpandedYearFormat(2), WithDateForm(WeekDateForm), WithPrecision(PrecisionDay)}, want: "-000001-W52-5"},
+		{name: "quarter of leap year", t: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), opts: []FormatOptions{WithDateForm(QuarterDateForm), WithPrecision(PrecisionDay)}, want: "2024-Q2-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Format(tt.t, tt.opts...)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+	times := []time.Time{
+		time.Date(2012, 12, 24, 9, 5, 7, 500000000, time.FixedZone("", 9*3600)),
+		time.Date(2021, 1, 3, 23, 59, 59, 999999999, time.UTC),
+		time.Date(2020, 12, 31, 0, 0, 0, 0, time.FixedZone("", -(3*3600+30*60))),
+		time.Date(1900, 2, 28, 12, 0, 0, 1000, time.FixedZone("", 9*3600+18*60+59)),
+		time.Date(2024, 2, 29, 6, 7, 8, 0, time.UTC),
+		time.Date(0, 1, 3, 0, 0, 0, 0, time.UTC), // January 1 and 2 are in the week-based year -1.
+		time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+	}
+	forms := []DateForm{CalendarDateForm, OrdinalDateForm, WeekDateForm, QuarterDateForm}
+	for _, tm := range times {
+		for _, form := range forms {
+			for _, opts := range [][]FormatOptions{
+				{WithDateForm(form)},
+				{WithDateForm(form), WithBasicFormat()},
+				{WithDateForm(form), WithNumericUTC()},
+				{WithDateForm(form), WithBasicFormat(), WithFractionDigits(9)},
+			} {
+				s := Format(tm, opts...)
+				got, err := ParseDateTime(s)
+				if err != nil {
+					t.Errorf("%s: %v", s, err)
+					continue
+				}
+				if !tm.Equal(got) {
+					t.Errorf("%s: want %v but got %v", s, tm, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormat_ExpandedYearRoundTrip(t *testing.T) {
+	times := []time.Time{
+		time.Date(12024, 3, 1, 13, 0, 0, 0, time.UTC),
+		time.Date(-44, 3, 15, 12, 30, 0, 0, time.FixedZone("", 3600)),
+		time.Date(-4, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(999999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+	}
+	forms := []DateForm{CalendarDateForm, OrdinalDateForm, WeekDateForm, QuarterDateForm}
+	for _, tm := range times {
+		for _, form := range forms {
+			for _, opts := range [][]FormatOptions{
+				{WithDateForm(form), WithExpandedYearFormat(2)},
+				{WithDateForm(form), WithExpandedYearFormat(2), WithBasicFormat()},
+			} {
+				s := Format(tm, opts...)
+				got, err := ParseDateTime(s, WithExpandedYear(2))
+				if err != nil {
+					t.Errorf("%s: %v", s, err)
+					continue
+				}
+				if !tm.Equal(got) {
+					t.Errorf("%s: want %v but got %v", s, tm, got)
+				}
+			}
+		}
+	}
+}
+
+func TestDateLike_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		d    interface {
+			Format(...FormatOptions) string
+			AppendFormat([]byte, ...FormatOptions) []byte
+		}
+		opts []FormatOptions
+		want string
+	}{
+		{name: "date", d: Date{Year: 2012, Month: 12, Day: 24}, want: "2012-12-24"},
+		{name: "date basic", d: Date{Year: 2012, Month: 12, Day: 24}, opts: []FormatOptions{WithBasicFormat()}, want: "20121224"},
+		{name: "date as week date", d: Date{Year: 2012, Month: 12, Day: 24}, opts: []FormatOptions{WithDateForm(WeekDateForm)}, want: "2012-W52-1"},
+		{name: "quarter date", d: QuarterDate{Year: 2012, Quarter: 4, Day: 85}, want: "2012-Q4-85"},
+		{name: "quarter date basic", d: QuarterDate{Year: 2012, Quarter: 4, Day: 85}, opts: []FormatOptions{WithBasicFormat()}, want: "2012Q485"},
+		{name: "week date", d: WeekDate{Year: 2020, Week: 53, Day: 7}, want: "2020-W53-7"},
+		{name: "week date basic", d: WeekDate{Year: 2020, Week: 53, Day: 7}, opts: []FormatOptions{WithBasicFormat()}, want: "2020W537"},
+		{name: "week date as calendar date", d: WeekDate{Year: 2020, Week: 53, Day: 7}, opts: []FormatOptions{WithDateForm(CalendarDateForm)}, want: "2021-01-03"},
+		{name: "ordinal date", d: OrdinalDate{Year: 2012, Day: 359}, want: "2012-359"},
+		{name: "ordinal date basic", d: OrdinalDate{Year: 2012, Day: 359}, opts: []FormatOptions{WithBasicFormat()}, want: "2012359"},
+		{name: "ignored options", d: OrdinalDate{Year: 2012, Day: 5}, opts: []FormatOptions{WithPrecision(PrecisionHour), WithNumericUTC()}, want: "2012-005"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Format(tt.opts...)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			if appended := string(tt.d.AppendFormat([]byte("date="), tt.opts...)); appended != "date="+tt.want {
+				t.Errorf("AppendFormat: want %q but got %q", "date="+tt.want, appended)
+			}
+			d, err := ParseDate(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := tt.d.(DateLike).Date(); want != d.Date() {
+				t.Errorf("round trip: want %v but got %v", want, d.Date())
+			}
+		})
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	jst := time.FixedZone("JST", 9*3600)
+	tm := time.Date(2012, 12, 24, 9, 5, 7, 500000000, jst)
+
+	got := AppendFormat([]byte("time="), tm)
+	if diff := cmp.Diff("time=2012-12-24T09:05:07.5+09:00", string(got)); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	got = AppendFormat(nil, tm, WithBasicFormat(), WithPrecision(PrecisionMinute))
+	if diff := cmp.Diff("20121224T0905+0900", string(got)); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	t.Run("allocs", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = AppendFormat(buf[:0], tm)
+		})
+		if allocs != 0 {
+			t.Errorf("want no allocations but got %v", allocs)
+		}
+	})
+}
+
+func BenchmarkFormat(b *testing.B) {
+	tm := time.Date(2012, 12, 24, 9, 5, 7, 123456789, time.FixedZone("", 9*3600))
+	buf := make([]byte, 0, 64)
+	b.Run("iso8601.AppendFormat", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf = AppendFormat(buf[:0], tm)
+		}
+	})
+	b.Run("time.AppendFormat", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf = tm.AppendFormat(buf[:0], time.RFC3339Nano)
+		}
+	})
+	b.Run("iso8601.Format", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Format(tm)
+		}
+	})
+	b.Run("time.Format", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = tm.Format(time.RFC3339Nano)
+		}
+	})
+}
@@ -0,0 +1,334 @@
+package iso8601
+
+import (
+	"fmt"
+	"time"
+)
+
+// Interval represents an ISO8601 time interval. An interval is expressed
+// by one of the following forms:
+//
+//	<start>/<end>        2007-03-01T13:00:00Z/2008-05-11T15:30:00Z
+//	<start>/<duration>   2007-03-01T13:00:00Z/P1Y2M10DT2H30M
+//	<duration>/<end>     P1Y2M10DT2H30M/2008-05-11T15:30:00Z
+//
+// For the <start>/<duration> form, End is the zero time.Time. For the
+// <duration>/<end> form, Start is the zero time.Time. Duration is set only
+// when the interval is expressed with a duration.
+type Interval struct {
+	Start    time.Time
+	End      time.Time
+	Duration Duration
+}
+
+var _ fmt.Stringer = Interval{}
+
+// HasStart reports whether the interval has an explicit start.
+func (i Interval) HasStart() bool { return !i.Start.IsZero() }
+
+// HasEnd reports whether the interval has an explicit end.
+func (i Interval) HasEnd() bool { return !i.End.IsZero() }
+
+// String returns the ISO8601 string representation of the interval.
+// The start and end are formatted in RFC 3339 format with sub-second precision.
+// For example: "2007-03-01T13:00:00Z/P1Y2M10DT2H30M".
+func (i Interval) String() string {
+	start := i.Duration.String()
+	if i.HasStart() {
+		start = i.Start.Format(time.RFC3339Nano)
+	}
+	end := i.Duration.String()
+	if i.HasEnd() {
+		end = i.End.Format(time.RFC3339Nano)
+	}
+	return start + "/" + end
+}
+
+// ParseInterval attempts to parse a given byte slice representing a time interval
+// in the ISO 8601 format. Supported formats include:
+//
+//	<start>/<end>        2007-03-01T13:00:00Z/2008-05-11T15:30:00Z
+//	<start>/<duration>   2007-03-01T13:00:00Z/P1Y2M10DT2H30M
+//	<duration>/<end>     P1Y2M10DT2H30M/2008-05-11T15:30:00Z
+//
+// The start and end are parsed with ParseDateTime, and the duration is parsed
+// with ParseDuration. "--" can be used instead of "/" as the separator.
+//
+// In the <start>/<end> form, the end may omit the leading elements of the
+// date which are the same as the start. Such elements and the time zone are
+// taken from the start. The time of the end always starts with the hour, and
+// the end without the date must have the hour and the minute. For example:
+//
+//	2007-12-14T13:30/15:30         (2007-12-14T13:30/2007-12-14T15:30)
+//	2007-12-14T13:30:00/15:30      (2007-12-14T13:30:00/2007-12-14T15:30)
+//	2008-02-15/03-14               (2008-02-15/2008-03-14)
+//	2007-11-13T09:00Z/15T17:00     (2007-11-13T09:00Z/2007-11-15T17:00Z)
+//
+// An error is returned if the elements of the end do not line up with the
+// start, such as "2008-02-15T10:00/16".
+//
+// The options are used to parse the start and end.
+//
+// The function returns an Interval structure or an error if the parsing fails.
+// b is not copied, and no allocation happens on success without options
+// unless the end omits the components.
+func ParseInterval[bytes []byte | ~string](b bytes, opts ...ParseDateTimeOptions) (Interval, error) {
+	o := newParseDateTimeOptions(opts)
+	return parseInterval(b, &o)
+}
+
+func parseInterval[bytes []byte | ~string](b bytes, o *parseDateTimeOptions) (Interval, error) {
+	sep, sepLen := indexByte(b, '/'), 1
+	if sep < 0 {
+		sep, sepLen = indexDoubleHyphen(b), 2
+	}
+	if sep < 0 {
+		return Interval{}, &UnexpectedTokenError{
+			Value:    string(b),
+			Token:    string(b),
+			Expected: "'/' or '--' separator",
+			Length:   len(b),
+			Kind:     KindUnexpectedToken,
+		}
+	}
+	first, second := b[:sep], b[sep+sepLen:]
+	firstIsDuration, secondIsDuration := isDuration(first), isDuration(second)
+
+	switch {
+	case firstIsDuration && secondIsDuration:
+		return Interval{}, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(second),
+			AfterToken: string(b[:sep+sepLen]),
+			Expected:   "datetime after the duration",
+			Offset:     sep + sepLen,
+			Length:     len(second),
+			Kind:       KindUnexpectedToken,
+		}
+	case firstIsDuration: // <duration>/<end>
+		d, err := parseDuration(first)
+		if err != nil {
+			return Interval{}, overrideErrorPosition(err, b, 0)
+		}
+		end, err := parseDateTime(second, o)
+		if err != nil {
+			return Interval{}, overrideErrorPosition(err, b, sep+sepLen)
+		}
+		return Interval{End: end, Duration: d}, nil
+	case secondIsDuration: // <start>/<duration>
+		start, err := parseDateTime(first, o)
+		if err != nil {
+			return Interval{}, overrideErrorPosition(err, b, 0)
+		}
+		d, err := parseDuration(second)
+		if err != nil {
+			return Interval{}, overrideErrorPosition(err, b, sep+sepLen)
+		}
+		return Interval{Start: start, Duration: d}, nil
+	}
+
+	// <start>/<end>
+	start, err := parseDateTime(first, o)
+	if err != nil {
+		return Interval{}, overrideErrorPosition(err, b, 0)
+	}
+	end, err := parseDateTime(second, o)
+	if err != nil {
+		var buf [64]byte
+		completed, prefix, abbreviated, ok := completeIntervalEnd(buf[:0], first, second, o)
+		if !abbreviated {
+			return Interval{}, overrideErrorPosition(err, b, sep+sepLen)
+		}
+		if !ok {
+			return Interval{}, &UnexpectedTokenError{
+				Value:      string(b),
+				Token:      string(second),
+				AfterToken: string(b[:sep+sepLen]),
+				Expected:   "end whose elements line up with the start",
+				Offset:     sep + sepLen,
+				Length:     len(second),
+				Kind:       KindUnexpectedToken,
+			}
+		}
+		end, err = parseDateTime(completed, o)
+		if err != nil {
+			// The completed end has the prefix taken from the start.
+			// Point to the beginning of the end if the error is in the prefix.
+			err = overrideErrorPosition(err, b, sep+sepLen-prefix)
+			if perr, ok := err.(PositionError); ok {
+				if offset, _ := perr.Position(); offset < sep+sepLen {
+					err = overrideErrorPosition(err, b, sep+sepLen-offset)
+				}
+			}
+			return Interval{}, err
+		}
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+func isDuration[bytes []byte | ~string](b bytes) bool {
+	if len(b) > 0 && (b[0] == '+' || b[0] == '-') {
+		b = b[1:]
+	}
+	return len(b) > 0 && b[0] == 'P'
+}
+
+// completeIntervalEnd fills the omitted leading elements of the end with
+// the elements of the start, and appends the completed end to dst. It also
+// returns the length of the elements taken from the start.
+//
+// The end is completed per element: the date of the end is aligned with the
+// trailing elements of the date of the start, and the time of the end always
+// starts with the hour. If the start has a time and the end does not have
+// the time designator, the end is the time, which must have the hour and the
+// minute so that it is not mistaken for the day.
+//
+// abbreviated reports whether the end omits any element. ok reports whether
+// the elements of the end line up with the start.
+func completeIntervalEnd[bytes []byte | ~string](dst []byte, start, end bytes, o *parseDateTimeOptions) (completed []byte, prefix int, abbreviated, ok bool) {
+	startDesignator := indexTimeDesignator(start, &o.timeDesignators)
+	endDesignator := indexTimeDesignator(end, &o.timeDesignators)
+	endIsTime := startDesignator >= 0 && endDesignator < 0
+
+	startBody, startZone := splitZone(start, startDesignator, false)
+	endBody, endZone := splitZone(end, endDesignator, endIsTime)
+	if len(endZone) == 0 {
+		endZone = startZone
+	}
+	startDate, startTime := startBody, startBody[len(startBody):]
+	if startDesignator >= 0 {
+		startDate, startTime = startBody[:startDesignator], startBody[startDesignator+1:]
+	}
+
+	if endIsTime {
+		if len(endBody) == 0 {
+			return nil, 0, false, false
+		}
+		if !isLinedUpTime(startTime, endBody) || !(isExtendedTime(endBody) || countDigits(endBody, 0) >= 4) {
+			return nil, 0, true, false
+		}
+		prefix = startDesignator + 1
+		dst = append(dst, startBody[:prefix]...)
+		dst = append(dst, endBody...)
+		dst = append(dst, endZone...)
+		return dst, prefix, true, true
+	}
+
+	endDate := endBody
+	if endDesignator >= 0 {
+		endDate = endBody[:endDesignator]
+	}
+	if len(endDate) >= len(startDate) || (len(endDate) == 0 && endDesignator < 0) {
+		return nil, 0, false, false
+	}
+	if endDesignator >= 0 && !isLinedUpTime(startTime, endBody[endDesignator+1:]) {
+		return nil, 0, true, false
+	}
+	prefix, ok = alignDate(startDate, endDate, o.expandedYear)
+	if !ok {
+		return nil, 0, true, false
+	}
+	dst = append(dst, startDate[:prefix]...)
+	dst = append(dst, endBody...)
+	dst = append(dst, endZone...)
+	return dst, prefix, true, true
+}
+
+// alignDate returns the index of the element of the date start from which
+// the elements have the same layout as the abbreviated date end.
+func alignDate[bytes []byte | ~string](start, end bytes, expandedYear int) (int, bool) {
+	if len(end) == 0 {
+		return len(start), true
+	}
+	n, d, err := parseDate(start, expandedYear)
+	if err != nil || n != len(start) {
+		return 0, false
+	}
+	sep := 0
+	if indexByte(trimSign(start), '-') >= 0 {
+		sep = 1
+	}
+	// The indices of the last and the second to last elements.
+	var elems [2]int
+	switch d.form {
+	case OrdinalDateForm: // [YYYY-]DDD
+		elems = [2]int{n - 3, n - 3}
+	case WeekDateForm: // [YYYY-]Www[-D]
+		elems = [2]int{n - 1, n - 4 - sep}
+	default: // [YYYY-]MM[-DD], [YYYY-]Qq[-DD]
+		elems = [2]int{n - 2, n - 4 - sep}
+	}
+	for _, i := range elems {
+		if len(start)-i == len(end) && isSameLayout(start[i:], end) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// isSameLayout reports whether a and b, which have the same length, have
+// the digits and the other bytes at the same positions.
+func isSameLayout[bytes []byte | ~string](a, b bytes) bool {
+	for i := 0; i < len(a); i++ {
+		if isDigit(a[i]) != isDigit(b[i]) || (!isDigit(a[i]) && a[i] != b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLinedUpTime reports whether the time of the end is in the same format,
+// basic or extended, as the time of the start.
+func isLinedUpTime[bytes []byte | ~string](start, end bytes) bool {
+	if len(start) <= 2 || len(end) <= 2 {
+		return true // hh is in both of the formats
+	}
+	return isExtendedTime(start) == isExtendedTime(end)
+}
+
+func isExtendedTime[bytes []byte | ~string](b bytes) bool {
+	return len(b) > 2 && b[2] == ':'
+}
+
+// splitZone splits the datetime into the body and the time zone designator.
+// designator is the index of the time designator. If isTime is true, b is
+// considered as the time without the date.
+func splitZone[bytes []byte | ~string](b bytes, designator int, isTime bool) (body, zone bytes) {
+	if designator < 0 && !isTime {
+		return b, b[len(b):]
+	}
+	for i := designator + 1; i < len(b); i++ {
+		switch b[i] {
+		case 'Z', '+', '-':
+			return b[:i], b[i:]
+		}
+	}
+	return b, b[len(b):]
+}
+
+func indexByte[bytes []byte | ~string](b bytes, c byte) int {
+	for i := 0; i < len(b); i++ {
+		if b[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexDoubleHyphen[bytes []byte | ~string](b bytes) int {
+	for i := 0; i+1 < len(b); i++ {
+		if b[i] == '-' && b[i+1] == '-' {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexTimeDesignator[bytes []byte | ~string](b bytes, d *timeDesignators) int {
+	for i := 0; i < len(b); i++ {
+		if d.contains(b[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,236 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Interval
+		wantErr error
+	}{
+		{
+			name: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+			want: Interval{
+				Start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+				End:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-03-01T13:00:00Z--2008-05-11T15:30:00Z",
+			want: Interval{
+				Start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+				End:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
+			want: Interval{
+				Start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+				Duration: Duration{
+					Year:   1,
+					Month:  2,
+					Day:    10,
+					Hour:   2,
+					Minute: 30,
+				},
+			},
+		},
+		{
+			name: "P1Y2M10DT2H30M/2008-05-11T15:30:00Z",
+			want: Interval{
+				End: time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+				Duration: Duration{
+					Year:   1,
+					Month:  2,
+					Day:    10,
+					Hour:   2,
+					Minute: 30,
+				},
+			},
+		},
+		{
+			name: "2007-12-14T13:30/15:30",
+			want: Interval{
+				Start: time.Date(2007, 12, 14, 13, 30, 0, 0, time.UTC),
+				End:   time.Date(2007, 12, 14, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "20071214T1330/1530",
+			want: Interval{
+				Start: time.Date(2007, 12, 14, 13, 30, 0, 0, time.UTC),
+				End:   time.Date(2007, 12, 14, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-12-14T13:30:00/15:30",
+			want: Interval{
+				Start: time.Date(2007, 12, 14, 13, 30, 0, 0, time.UTC),
+				End:   time.Date(2007, 12, 14, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2008-02-15/03-14",
+			want: Interval{
+				Start: time.Date(2008, 2, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2008, 3, 14, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-11-13T09:00Z/15T17:00",
+			want: Interval{
+				Start: time.Date(2007, 11, 13, 9, 0, 0, 0, time.UTC),
+				End:   time.Date(2007, 11, 15, 17, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2012-W52-1/3",
+			want: Interval{
+				Start: time.Date(2012, 12, 24, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2012, 12, 26, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-12-14T13:30+09:00/15:30",
+			want: Interval{
+				Start: time.Date(2007, 12, 14, 13, 30, 0, 0, time.FixedZone("", 9*3600)),
+				End:   time.Date(2007, 12, 14, 15, 30, 0, 0, time.FixedZone("", 9*3600)),
+			},
+		},
+		{
+			name: "2007-12-14T13:30+09:00/15:30Z",
+			want: Interval{
+				Start: time.Date(2007, 12, 14, 13, 30, 0, 0, time.FixedZone("", 9*3600)),
+				End:   time.Date(2007, 12, 14, 15, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "2007-03-01T13:00:00Z",
+			wantErr: &UnexpectedTokenError{
+				Value:    "2007-03-01T13:00:00Z",
+				Token:    "2007-03-01T13:00:00Z",
+				Expected: "'/' or '--' separator",
+				Length:   20,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			name: "P1D/P2D",
+			wantErr: &UnexpectedTokenError{
+				Value:      "P1D/P2D",
+				Token:      "P2D",
+				AfterToken: "P1D/",
+				Expected:   "datetime after the duration",
+				Offset:     4,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			name: "2007-03-01/2007-13-01",
+			wantErr: &DateLikeRangeError{
+				Element: "month",
+				Value:   13,
+				Year:    2007,
+				Min:     1,
+				Max:     12,
+				Offset:  16,
+				Length:  2,
+			},
+		},
+		{
+			name: "2008-02-15T10:00/16",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2008-02-15T10:00/16",
+				Token:      "16",
+				AfterToken: "2008-02-15T10:00/",
+				Expected:   "end whose elements line up with the start",
+				Offset:     17,
+				Length:     2,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			name: "2007-12-14T13:30/1530",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2007-12-14T13:30/1530",
+				Token:      "1530",
+				AfterToken: "2007-12-14T13:30/",
+				Expected:   "end whose elements line up with the start",
+				Offset:     17,
+				Length:     4,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			name: "2007-03-01/P1X",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2007-03-01/P1X",
+				Token:      "X",
+				AfterToken: "P1",
+				Expected:   "PnYnMnDTnHnMnS or PnW format",
+				Offset:     13,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInterval(tt.name)
+			if tt.wantErr != nil {
+				if diff := cmp.Diff(tt.wantErr, err); diff != "" {
+					t.Errorf("error: (-want, +got)\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInterval_String(t *testing.T) {
+	tests := []struct {
+		interval Interval
+		want     string
+	}{
+		{
+			interval: Interval{
+				Start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+				End:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			},
+			want: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+		},
+		{
+			interval: Interval{
+				Start:    time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+				Duration: Duration{Month: 1},
+			},
+			want: "2007-03-01T13:00:00Z/P1M",
+		},
+		{
+			interval: Interval{
+				End:      time.Date(2008, 5, 11, 15, 30, 0, 0, time.FixedZone("", -3600)),
+				Duration: Duration{Day: 1},
+			},
+			want: "P1D/2008-05-11T15:30:00-01:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.interval.String(); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
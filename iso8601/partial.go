@@ -0,0 +1,303 @@
+package iso8601
+
+import (
+	"fmt"
+	"time"
+)
+
+// PartialDate defines an interface for dates represented with reduced
+// precision, such as a year or a month. A partial date covers the range of
+// calendar dates from Start to End.
+type PartialDate interface {
+	// Start returns the first date in the partial date.
+	Start() Date
+
+	// End returns the last date in the partial date.
+	End() Date
+
+	// IsValid checks whether the partial date is valid.
+	IsValid() bool
+
+	// Validate checks the correctness of the partial date and returns an error if it's invalid.
+	Validate() error
+}
+
+// Century represents a century, which is the 100 years from "Century"00 to
+// "Century"99. For example, the century 19 is from 1900 to 1999.
+type Century struct {
+	Century int
+}
+
+var _ interface {
+	PartialDate
+	fmt.Stringer
+} = Century{}
+
+// String returns the ISO8601 string representation of the format "CC".
+// For example: "19".
+func (c Century) String() string {
+	return fmt.Sprintf("%02d", c.Century)
+}
+
+// Start returns the first date in the century.
+func (c Century) Start() Date {
+	return Date{Year: c.Century * 100, Month: time.January, Day: 1}
+}
+
+// End returns the last date in the century.
+func (c Century) End() Date {
+	return Date{Year: c.Century*100 + 99, Month: time.December, Day: 31}
+}
+
+// IsValid checks if the century is valid.
+func (c Century) IsValid() bool {
+	return c.Validate() == nil
+}
+
+// Validate returns an error if the century is out of the expected range.
+func (c Century) Validate() error {
+	if c.Century < 0 || c.Century > 99 {
+		return &DateLikeRangeError{
+			Element: "century",
+			Value:   c.Century,
+			Year:    c.Century * 100,
+			Min:     0,
+			Max:     99,
+		}
+	}
+	return nil
+}
+
+// Year represents a calendar year.
+type Year struct {
+	Year int
+}
+
+var _ interface {
+	PartialDate
+	fmt.Stringer
+} = Year{}
+
+// String returns the ISO8601 string representation of the format "YYYY".
+// For example: "2024".
+func (y Year) String() string {
+	return fmt.Sprintf("%04d", y.Year)
+}
+
+// Start returns the first date in the year.
+func (y Year) Start() Date {
+	return Date{Year: y.Year, Month: time.January, Day: 1}
+}
+
+// End returns the last date in the year.
+func (y Year) End() Date {
+	return Date{Year: y.Year, Month: time.December, Day: 31}
+}
+
+// IsValid checks if the year is valid.
+func (y Year) IsValid() bool {
+	return y.Validate() == nil
+}
+
+// Validate returns an error if the year is out of the expected range.
+func (y Year) Validate() error {
+	return validateYear(y.Year)
+}
+
+// YearMonth represents a calendar month in a specific year.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+var _ interface {
+	PartialDate
+	fmt.Stringer
+} = YearMonth{}
+
+// String returns the ISO8601 string representation of the format "YYYY-MM".
+// For example: "2024-03".
+func (ym YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", ym.Year, ym.Month)
+}
+
+// Start returns the first date in the month.
+func (ym YearMonth) Start() Date {
+	return Date{Year: ym.Year, Month: ym.Month, Day: 1}
+}
+
+// End returns the last date in the month.
+func (ym YearMonth) End() Date {
+	return Date{Year: ym.Year, Month: ym.Month, Day: daysInMonth(ym.Year, int(ym.Month))}
+}
+
+// IsValid checks if the month is valid.
+func (ym YearMonth) IsValid() bool {
+	return ym.Validate() == nil
+}
+
+// Validate checks the year and the month, and returns an error if any of
+// them are out of the expected ranges.
+func (ym YearMonth) Validate() error {
+	if err := validateYear(ym.Year); err != nil {
+		return err
+	}
+	if ym.Month < 1 || ym.Month > 12 {
+		return &DateLikeRangeError{
+			Element: "month",
+			Value:   int(ym.Month),
+			Year:    ym.Year,
+			Min:     1,
+			Max:     12,
+		}
+	}
+	return nil
+}
+
+// YearWeek represents an ISO 8601 week in a specific week-numbering year.
+type YearWeek struct {
+	Year int
+	Week int
+}
+
+var _ interface {
+	PartialDate
+	fmt.Stringer
+} = YearWeek{}
+
+// String returns the ISO8601 string representation of the format "YYYY-Www".
+// For example: "2024-W10".
+func (yw YearWeek) String() string {
+	return fmt.Sprintf("%04d-W%02d", yw.Year, yw.Week)
+}
+
+// Start returns the first date, Monday, in the week.
+func (yw YearWeek) Start() Date {
+	return WeekDate{Year: yw.Year, Week: yw.Week, Day: 1}.Date()
+}
+
+// End returns the last date, Sunday, in the week.
+func (yw YearWeek) End() Date {
+	return WeekDate{Year: yw.Year, Week: yw.Week, Day: 7}.Date()
+}
+
+// IsValid checks if the week is valid.
+func (yw YearWeek) IsValid() bool {
+	return yw.Validate() == nil
+}
+
+// Validate checks the year and the week, and returns an error if any of
+// them are out of the expected ranges.
+func (yw YearWeek) Validate() error {
+	return WeekDate{Year: yw.Year, Week: yw.Week, Day: 1}.Validate()
+}
+
+// ParsePartialDate attempts to parse a given byte slice representing a date
+// with reduced precision in ISO 8601 formats. Supported formats include:
+//
+//	Basic     Extended
+//	20        N/A         Century
+//	2024      N/A         Year
+//	N/A       2024-03     Calendar month
+//	2024W10   2024-W10    Week
+//
+// Note that the basic format of calendar months such as "202403" is not
+// allowed by ISO 8601. Use ParseDate to parse complete dates.
+//
+// The function returns an implementation of PartialDate or an error if the parsing fails.
+// b is not copied, and the only allocation on success is for the returned PartialDate.
+func ParsePartialDate[bytes []byte | ~string](b bytes) (PartialDate, error) {
+	n, d, err := parsePartialDate(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[n:]),
+			AfterToken: string(b[:n]),
+			Expected:   string(b[:n]),
+			Offset:     n,
+			Length:     len(b) - n,
+			Kind:       KindExtraText,
+		}
+	}
+	return d, nil
+}
+
+func parsePartialDate[bytes []byte | ~string](b bytes) (int, PartialDate, error) {
+	n := countDigits(b, 0)
+	switch n {
+	case 2: // 20
+		c := Century{Century: parseNumber(b, 0, 2)}
+		return 2, c, c.Validate()
+	case 4: // 2024
+	default:
+		return 0, nil, &UnexpectedTokenError{
+			Value:    string(b),
+			Token:    humanizeDigits(n),
+			Expected: "2-digit century or 4-digit year",
+			Length:   tokenLength(b, 0, n),
+			Kind:     tokenKind(b, 0),
+		}
+	}
+	year := parseNumber(b, 0, 4)
+	if len(b) == 4 {
+		y := Year{Year: year}
+		return 4, y, y.Validate()
+	}
+
+	i := 4
+	if b[i] == '-' {
+		i++
+	}
+	if i < len(b) && b[i] == 'W' { // 2024-W10 | 2024W10
+		if c := countDigits(b, i+1); c != 2 {
+			return 0, nil, &UnexpectedTokenError{
+				Value:      string(b),
+				Token:      humanizeDigits(c),
+				AfterToken: string(b[:i+1]),
+				Expected:   humanizeDigits(2),
+				Offset:     i + 1,
+				Length:     tokenLength(b, i+1, c),
+				Kind:       tokenKind(b, i+1),
+			}
+		}
+		yw := YearWeek{Year: year, Week: parseNumber(b, i+1, 2)}
+		if err := yw.Validate(); err != nil {
+			return 0, nil, withElementPosition(err, map[string][2]int{
+				"week": {i + 1, 2},
+			})
+		}
+		return i + 3, yw, nil
+	}
+	if i == 4 {
+		return 0, nil, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[4:]),
+			AfterToken: string(b[:4]),
+			Expected:   "- or W",
+			Offset:     4,
+			Length:     len(b) - 4,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	if c := countDigits(b, i); c != 2 { // 2024-03
+		return 0, nil, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      humanizeDigits(c),
+			AfterToken: string(b[:i]),
+			Expected:   "2-digit month or W",
+			Offset:     i,
+			Length:     tokenLength(b, i, c),
+			Kind:       tokenKind(b, i),
+		}
+	}
+	ym := YearMonth{Year: year, Month: time.Month(parseNumber(b, i, 2))}
+	if err := ym.Validate(); err != nil {
+		return 0, nil, withElementPosition(err, map[string][2]int{
+			"month": {i, 2},
+		})
+	}
+	return i + 2, ym, nil
+}
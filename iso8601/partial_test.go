@@ -0,0 +1,213 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePartialDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    PartialDate
+		wantErr error
+	}{
+		{name: "20", want: Century{Century: 20}},
+		{name: "00", want: Century{Century: 0}},
+		{name: "2024", want: Year{Year: 2024}},
+		{name: "0000", want: Year{Year: 0}},
+		{name: "2024-03", want: YearMonth{Year: 2024, Month: time.March}},
+		{name: "2024-W10", want: YearWeek{Year: 2024, Week: 10}},
+		{name: "2024W10", want: YearWeek{Year: 2024, Week: 10}},
+		{name: "2020-W53", want: YearWeek{Year: 2020, Week: 53}},
+		{
+			name: "2024-13",
+			wantErr: &DateLikeRangeError{
+				Element: "month",
+				Value:   13,
+				Year:    2024,
+				Min:     1,
+				Max:     12,
+			},
+		},
+		{
+			name: "2024-W53",
+			wantErr: &DateLikeRangeError{
+				Element: "week",
+				Value:   53,
+				Year:    2024,
+				Min:     1,
+				Max:     52,
+			},
+		},
+		{
+			name: "2024-W00",
+			wantErr: &DateLikeRangeError{
+				Element: "week",
+				Value:   0,
+				Year:    2024,
+				Min:     1,
+				Max:     52,
+			},
+		},
+		{
+			name: "202403",
+			wantErr: &UnexpectedTokenError{
+				Value:    "202403",
+				Token:    "6-digits",
+				Expected: "2-digit century or 4-digit year",
+			},
+		},
+		{
+			name: "2",
+			wantErr: &UnexpectedTokenError{
+				Value:    "2",
+				Token:    "1-digit",
+				Expected: "2-digit century or 4-digit year",
+			},
+		},
+		{
+			name: "",
+			wantErr: &UnexpectedTokenError{
+				Value:    "",
+				Token:    "0-digit",
+				Expected: "2-digit century or 4-digit year",
+			},
+		},
+		{
+			name: "2024Q1",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2024Q1",
+				Token:      "Q1",
+				AfterToken: "2024",
+				Expected:   "- or W",
+			},
+		},
+		{
+			name: "2024-3",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2024-3",
+				Token:      "1-digit",
+				AfterToken: "2024-",
+				Expected:   "2-digit month or W",
+			},
+		},
+		{
+			name: "2024-W1",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2024-W1",
+				Token:      "1-digit",
+				AfterToken: "2024-W",
+				Expected:   "2-digits",
+			},
+		},
+		{
+			name: "2024-03-01",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2024-03-01",
+				Token:      "-01",
+				AfterToken: "2024-03",
+				Expected:   "2024-03",
+			},
+		},
+		{
+			name: "2024-W10-1",
+			wantErr: &UnexpectedTokenError{
+				Value:      "2024-W10-1",
+				Token:      "-1",
+				AfterToken: "2024-W10",
+				Expected:   "2024-W10",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePartialDate(tt.name)
+			if tt.wantErr != nil {
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Fatalf("(-want, +got)\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			if s := got.(interface{ String() string }).String(); s != tt.name && tt.name != "2024W10" {
+				t.Errorf("String: want %q but got %q", tt.name, s)
+			}
+		})
+	}
+}
+
+func TestPartialDate_StartEnd(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         PartialDate
+		wantStart Date
+		wantEnd   Date
+	}{
+		{
+			name:      "century",
+			p:         Century{Century: 19},
+			wantStart: Date{Year: 1900, Month: time.January, Day: 1},
+			wantEnd:   Date{Year: 1999, Month: time.December, Day: 31},
+		},
+		{
+			name:      "year",
+			p:         Year{Year: 2024},
+			wantStart: Date{Year: 2024, Month: time.January, Day: 1},
+			wantEnd:   Date{Year: 2024, Month: time.December, Day: 31},
+		},
+		{
+			name:      "leap month",
+			p:         YearMonth{Year: 2024, Month: time.February},
+			wantStart: Date{Year: 2024, Month: time.February, Day: 1},
+			wantEnd:   Date{Year: 2024, Month: time.February, Day: 29},
+		},
+		{
+			name:      "week across years",
+			p:         YearWeek{Year: 2020, Week: 53},
+			wantStart: Date{Year: 2020, Month: time.December, Day: 28},
+			wantEnd:   Date{Year: 2021, Month: time.January, Day: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.wantStart, tt.p.Start()); diff != "" {
+				t.Errorf("Start (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantEnd, tt.p.End()); diff != "" {
+				t.Errorf("End (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPartialDate_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		p    PartialDate
+		want bool
+	}{
+		{name: "century", p: Century{Century: 99}, want: true},
+		{name: "negative century", p: Century{Century: -1}, want: false},
+		{name: "century 100", p: Century{Century: 100}, want: false},
+		{name: "year", p: Year{Year: 9999}, want: true},
+		{name: "year 10000", p: Year{Year: 10000}, want: false},
+		{name: "month 0", p: YearMonth{Year: 2024, Month: 0}, want: false},
+		{name: "invalid year of month", p: YearMonth{Year: -1, Month: 1}, want: false},
+		{name: "week 53", p: YearWeek{Year: 2026, Week: 53}, want: true},
+		{name: "invalid week 53", p: YearWeek{Year: 2027, Week: 53}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.IsValid(); got != tt.want {
+				t.Errorf("want %v but got %v (%v)", tt.want, got, tt.p.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// Profile represents a set of rules for the representations of date-times
+// accepted by ParseDateTime. Use WithProfile to select a profile.
+type Profile int
+
+const (
+	// ISO8601Profile accepts all the representations described in ParseDateTime.
+	// This is the default profile.
+	ISO8601Profile Profile = iota
+	// RFC3339Profile accepts only the date-time of RFC 3339, such as
+	// "2012-12-24T12:30:45.5+09:00". The time with seconds and the time zone
+	// designator are required, the fraction must be separated by '.', and
+	// 'T' and 'Z' may be lowercase.
+	RFC3339Profile
+	// W3CDTFProfile accepts only the date-times of W3C-DTF (W3C Date and
+	// Time Formats), which are "YYYY", "YYYY-MM", "YYYY-MM-DD",
+	// "YYYY-MM-DDThh:mmTZD", "YYYY-MM-DDThh:mm:ssTZD" and
+	// "YYYY-MM-DDThh:mm:ss.sTZD". The reduced dates are parsed as the
+	// first day of the year or the month in UTC.
+	W3CDTFProfile
+	// XMLSchemaProfile accepts only the xs:dateTime of XML Schema, such as
+	// "2012-12-24T12:30:45.5" or "2012-12-24T12:30:45.5+09:00". The time
+	// with seconds is required, the time zone designator is optional and
+	// its offset must be in the range [-14:00,+14:00]. Unlike XML Schema,
+	// the year is limited to 4 digits.
+	XMLSchemaProfile
+	// LenientProfile accepts the representations of ISO8601Profile and also
+	// lowercase 't' and 'z', ' ' as the time designator, and the "UTC" suffix
+	// which may be separated by ' ', such as "2012-12-24 12:30:45 UTC".
+	LenientProfile
+)
+
+// String returns the name of the profile.
+func (p Profile) String() string {
+	switch p {
+	case RFC3339Profile:
+		return "RFC 3339"
+	case W3CDTFProfile:
+		return "W3C-DTF"
+	case XMLSchemaProfile:
+		return "XML Schema dateTime"
+	case LenientProfile:
+		return "lenient"
+	}
+	return "ISO 8601"
+}
+
+// WithProfile is an option to parse date-times with the rules of the profile.
+// The profile is also used to parse the start and end of intervals.
+//
+// RFC3339Profile, W3CDTFProfile and XMLSchemaProfile accept only their own
+// time designators even if WithTimeDesignators is also given.
+//
+// By default, ISO8601Profile is used.
+func WithProfile(p Profile) ParseDateTimeOptions {
+	return func(o *parseDateTimeOptions) {
+		o.profile = p
+		switch p {
+		case RFC3339Profile:
+			o.timeDesignators.set('T', 't')
+		case W3CDTFProfile, XMLSchemaProfile:
+			o.timeDesignators.set('T')
+		case LenientProfile:
+			o.timeDesignators.add('t', ' ')
+		}
+	}
+}
+
+// isStrict reports whether the profile accepts only the extended calendar
+// dates and the extended times with minutes.
+func (p Profile) isStrict() bool {
+	return p == RFC3339Profile || p == W3CDTFProfile || p == XMLSchemaProfile
+}
+
+// parseReducedDate parses "YYYY" and "YYYY-MM" for W3CDTFProfile.
+func parseReducedDate[bytes []byte | ~string](b bytes) (time.Time, bool) {
+	if len(b) != 4 && len(b) != 7 {
+		return time.Time{}, false
+	}
+	n, d, err := parsePartialDate(b)
+	if err != nil || n != len(b) {
+		return time.Time{}, false
+	}
+	switch d.(type) {
+	case Year, YearMonth:
+		return d.Start().StdTime(), true
+	}
+	return time.Time{}, false
+}
+
+// checkProfileDate checks the date which is b[:n] for the profile.
+func checkProfileDate[bytes []byte | ~string](b bytes, n int, d parsedDate, p Profile) error {
+	if !p.isStrict() {
+		return nil
+	}
+	if d.form != CalendarDateForm || n != 10 || b[4] != '-' {
+		return &UnexpectedTokenError{
+			Value:    string(b),
+			Token:    string(b[:n]),
+			Expected: "YYYY-MM-DD (" + p.String() + ")",
+			Length:   n,
+			Kind:     KindUnexpectedToken,
+		}
+	}
+	return nil
+}
+
+// checkProfileTime checks the time which is b[i:i+n] and the time designator
+// which is b[i-1] for the profile.
+func checkProfileTime[bytes []byte | ~string](b bytes, i, n int, t Time, p Profile) error {
+	if !p.isStrict() {
+		return nil
+	}
+	if c := b[i-1]; c != 'T' && !(c == 't' && p == RFC3339Profile) {
+		expected := "T"
+		if p == RFC3339Profile {
+			expected = "T or t"
+		}
+		return &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(c),
+			AfterToken: string(b[:i-1]),
+			Expected:   expected + " (" + p.String() + ")",
+			Offset:     i - 1,
+			Length:     1,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	hasSeconds := n >= 8 && b[i+5] == ':'
+	expected := "hh:mm:ss"
+	if p == W3CDTFProfile {
+		expected = "hh:mm or hh:mm:ss"
+	}
+	if n < 5 || b[i+2] != ':' || !(hasSeconds || (n == 5 && p == W3CDTFProfile)) {
+		return &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[i : i+n]),
+			AfterToken: string(b[:i]),
+			Expected:   expected + " (" + p.String() + ")",
+			Offset:     i,
+			Length:     n,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	if n > 8 && b[i+8] != '.' {
+		return &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[i+8]),
+			AfterToken: string(b[:i+8]),
+			Expected:   ". (" + p.String() + ")",
+			Offset:     i + 8,
+			Length:     1,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	if t.Hour == 24 && p != XMLSchemaProfile {
+		return &TimeRangeError{
+			Element: "hour",
+			Value:   t.Hour,
+			Min:     0,
+			Max:     23,
+			Offset:  i,
+			Length:  2,
+		}
+	}
+	return nil
+}
+
+// checkProfileZone checks the time zone designator which is b[i:] for the profile.
+func checkProfileZone[bytes []byte | ~string](b bytes, i int, z Zone, p Profile) error {
+	if !p.isStrict() || len(b)-i == 1 { // Z
+		return nil
+	}
+	if len(b)-i != 6 || b[i+3] != ':' {
+		return &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[i:]),
+			AfterToken: string(b[:i]),
+			Expected:   "Z or +hh:mm or -hh:mm (" + p.String() + ")",
+			Offset:     i,
+			Length:     len(b) - i,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	if offset := z.Hour*60 + z.Minute; p == XMLSchemaProfile && offset > 14*60 {
+		return &TimeZoneRangeError{
+			Element: "offset in minutes",
+			Value:   offset,
+			Min:     0,
+			Max:     14 * 60,
+			Offset:  i,
+			Length:  len(b) - i,
+		}
+	}
+	return nil
+}
+
+// isUTCSuffix reports whether b is the designator of UTC which is accepted
+// by the profile in addition to "Z".
+func isUTCSuffix[bytes []byte | ~string](b bytes, p Profile) bool {
+	switch p {
+	case RFC3339Profile:
+		return len(b) == 1 && b[0] == 'z'
+	case LenientProfile:
+		if len(b) == 1 && b[0] == 'z' {
+			return true
+		}
+		if len(b) > 0 && b[0] == ' ' {
+			b = b[1:]
+		}
+		return len(b) == 3 && b[0] == 'U' && b[1] == 'T' && b[2] == 'C'
+	}
+	return false
+}
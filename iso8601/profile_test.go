@@ -0,0 +1,216 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithProfile(t *testing.T) {
+	jst := time.FixedZone("", 9*3600)
+	tests := []struct {
+		profile Profile
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45Z", want: time.Date(2012, 12, 24, 12, 30, 45, 0, time.UTC)},
+		{profile: RFC3339Profile, value: "2012-12-24t12:30:45.5z", want: time.Date(2012, 12, 24, 12, 30, 45, 500000000, time.UTC)},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45.123456789+09:00", want: time.Date(2012, 12, 24, 12, 30, 45, 123456789, jst)},
+		{profile: RFC3339Profile, value: "2012-12-24", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30Z", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45,5Z", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45+0900", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T12:30:45+09", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24 12:30:45Z", wantErr: true},
+		{profile: RFC3339Profile, value: "20121224T123045Z", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-W52-1T12:30:45Z", wantErr: true},
+		{profile: RFC3339Profile, value: "2012-12-24T24:00:00Z", wantErr: true},
+
+		{profile: W3CDTFProfile, value: "2012", want: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{profile: W3CDTFProfile, value: "2012-12", want: time.Date(2012, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{profile: W3CDTFProfile, value: "2012-12-24", want: time.Date(2012, 12, 24, 0, 0, 0, 0, time.UTC)},
+		{profile: W3CDTFProfile, value: "2012-12-24T12:30+09:00", want: time.Date(2012, 12, 24, 12, 30, 0, 0, jst)},
+		{profile: W3CDTFProfile, value: "2012-12-24T12:30:45.5Z", want: time.Date(2012, 12, 24, 12, 30, 45, 500000000, time.UTC)},
+		{profile: W3CDTFProfile, value: "2012-12-24T12:30", wantErr: true},
+		{profile: W3CDTFProfile, value: "2012-12-24T12Z", wantErr: true},
+		{profile: W3CDTFProfile, value: "2012-12-24T12:30.5Z", wantErr: true},
+		{profile: W3CDTFProfile, value: "2012-13", wantErr: true},
+		{profile: W3CDTFProfile, value: "2012W52", wantErr: true},
+		{profile: W3CDTFProfile, value: "2012-12-24t12:30Z", wantErr: true},
+
+		{profile: XMLSchemaProfile, value: "2012-12-24T12:30:45", want: time.Date(2012, 12, 24, 12, 30, 45, 0, time.UTC)},
+		{profile: XMLSchemaProfile, value: "2012-12-24T12:30:45.5-14:00", want: time.Date(2012, 12, 25, 2, 30, 45, 500000000, time.UTC)},
+		{profile: XMLSchemaProfile, value: "2012-12-24T24:00:00Z", want: time.Date(2012, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{profile: XMLSchemaProfile, value: "2012-12-24T12:30:45+14:30", wantErr: true},
+		{profile: XMLSchemaProfile, value: "2012-12-24T12:30Z", wantErr: true},
+		{profile: XMLSchemaProfile, value: "2012-12-24", wantErr: true},
+
+		{profile: LenientProfile, value: "2012-12-24 12:30:45 UTC", want: time.Date(2012, 12, 24, 12, 30, 45, 0, time.UTC)},
+		{profile: LenientProfile, value: "2012-12-24t12:30UTC", want: time.Date(2012, 12, 24, 12, 30, 0, 0, time.UTC)},
+		{profile: LenientProfile, value: "2012-12-24 12:30:45,5z", want: time.Date(2012, 12, 24, 12, 30, 45, 500000000, time.UTC)},
+		{profile: LenientProfile, value: "20121224T1230+0900", want: time.Date(2012, 12, 24, 12, 30, 0, 0, jst)},
+		{profile: LenientProfile, value: "2012-12-24 12:30:45 utc", wantErr: true},
+
+		{profile: ISO8601Profile, value: "2012-12-24 12:30:45Z", wantErr: true},
+		{profile: ISO8601Profile, value: "2012-12-24T12:30:45 UTC", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile.String()+"/"+tt.value, func(t *testing.T) {
+			got, err := ParseDateTime(tt.value, WithProfile(tt.profile), WithInLocation(time.UTC))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("want error but got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want.Equal(got) {
+				t.Errorf("want %v but got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWithProfile_Error(t *testing.T) {
+	tests := []struct {
+		profile Profile
+		value   string
+		want    error
+	}{
+		{
+			profile: RFC3339Profile,
+			value:   "2012-359T12:30:45Z",
+			want: &UnexpectedTokenError{
+				Value:    "2012-359T12:30:45Z",
+				Token:    "2012-359",
+				Expected: "YYYY-MM-DD (RFC 3339)",
+				Length:   8,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			profile: RFC3339Profile,
+			value:   "2012-12-24T12:30Z",
+			want: &UnexpectedTokenError{
+				Value:      "2012-12-24T12:30Z",
+				Token:      "12:30",
+				AfterToken: "2012-12-24T",
+				Expected:   "hh:mm:ss (RFC 3339)",
+				Offset:     11,
+				Length:     5,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			profile: XMLSchemaProfile,
+			value:   "2012-12-24T12:30:45,5Z",
+			want: &UnexpectedTokenError{
+				Value:      "2012-12-24T12:30:45,5Z",
+				Token:      ",",
+				AfterToken: "2012-12-24T12:30:45",
+				Expected:   ". (XML Schema dateTime)",
+				Offset:     19,
+				Length:     1,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			profile: W3CDTFProfile,
+			value:   "2012-12-24T12:30:45",
+			want: &UnexpectedTokenError{
+				Value:      "2012-12-24T12:30:45",
+				AfterToken: "2012-12-24T12:30:45",
+				Expected:   "time zone designator (W3C-DTF)",
+				Offset:     19,
+				Kind:       KindUnexpectedEnd,
+			},
+		},
+		{
+			profile: W3CDTFProfile,
+			value:   "2012-12-24T12:30:45+0900",
+			want: &UnexpectedTokenError{
+				Value:      "2012-12-24T12:30:45+0900",
+				Token:      "+0900",
+				AfterToken: "2012-12-24T12:30:45",
+				Expected:   "Z or +hh:mm or -hh:mm (W3C-DTF)",
+				Offset:     19,
+				Length:     5,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			profile: RFC3339Profile,
+			value:   "2012-12-24T24:00:00Z",
+			want: &TimeRangeError{
+				Element: "hour",
+				Value:   24,
+				Min:     0,
+				Max:     23,
+				Offset:  11,
+				Length:  2,
+			},
+		},
+		{
+			profile: XMLSchemaProfile,
+			value:   "2012-12-24T12:30:45-15:00",
+			want: &TimeZoneRangeError{
+				Element: "offset in minutes",
+				Value:   900,
+				Min:     0,
+				Max:     840,
+				Offset:  19,
+				Length:  6,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile.String()+"/"+tt.value, func(t *testing.T) {
+			_, err := ParseDateTime(tt.value, WithProfile(tt.profile))
+			if diff := cmp.Diff(tt.want, err); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithProfile_TimeDesignators(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ParseDateTimeOptions
+	}{
+		{name: "lenient then RFC 3339", opts: []ParseDateTimeOptions{WithProfile(LenientProfile), WithProfile(RFC3339Profile)}},
+		{name: "space then RFC 3339", opts: []ParseDateTimeOptions{WithTimeDesignators(' '), WithProfile(RFC3339Profile)}},
+		{name: "RFC 3339 then space", opts: []ParseDateTimeOptions{WithProfile(RFC3339Profile), WithTimeDesignators(' ')}},
+		{name: "space then XML Schema", opts: []ParseDateTimeOptions{WithTimeDesignators(' '), WithProfile(XMLSchemaProfile)}},
+		{name: "W3C-DTF then space", opts: []ParseDateTimeOptions{WithProfile(W3CDTFProfile), WithTimeDesignators(' ')}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParseDateTime("2012-12-24 12:30:45Z", tt.opts...); err == nil {
+				t.Errorf("want error but got %v", got)
+			}
+		})
+	}
+
+	_, err := ParseDateTime("2012-12-24 12:30:45Z", WithProfile(RFC3339Profile), WithTimeDesignators(' '))
+	want := &UnexpectedTokenError{
+		Value:      "2012-12-24 12:30:45Z",
+		Token:      " ",
+		AfterToken: "2012-12-24",
+		Expected:   "T or t (RFC 3339)",
+		Offset:     10,
+		Length:     1,
+		Kind:       KindUnexpectedToken,
+	}
+	if diff := cmp.Diff(want, err); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	if _, err := ParseDateTime("2012-12-24t12:30:45Z", WithProfile(LenientProfile), WithProfile(RFC3339Profile)); err != nil {
+		t.Errorf("want 't' to be accepted by RFC 3339: %v", err)
+	}
+}
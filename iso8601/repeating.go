@@ -0,0 +1,93 @@
+package iso8601
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RepeatingInterval represents an ISO8601 repeating (recurring) time interval
+// such as "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M".
+type RepeatingInterval struct {
+	// Repetitions is the number of the intervals. It is -1 if the number
+	// of the intervals is unbounded ("R/...").
+	Repetitions int
+	Interval    Interval
+}
+
+var _ fmt.Stringer = RepeatingInterval{}
+
+// IsUnbounded reports whether the number of the intervals is unbounded.
+func (r RepeatingInterval) IsUnbounded() bool { return r.Repetitions < 0 }
+
+// String returns the ISO8601 string representation of the repeating interval.
+// For example: "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M".
+func (r RepeatingInterval) String() string {
+	if r.IsUnbounded() {
+		return "R/" + r.Interval.String()
+	}
+	return "R" + strconv.Itoa(r.Repetitions) + "/" + r.Interval.String()
+}
+
+// ParseRepeatingInterval attempts to parse a given byte slice representing
+// a repeating time interval in the ISO 8601 format. Supported formats include:
+//
+//	Rn/<interval>   R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M
+//	R/<interval>    R/P1Y2M10DT2H30M/2008-05-11T15:30:00Z
+//
+// The interval is parsed with ParseInterval. If the number of repetitions is
+// omitted, the number of the intervals is unbounded and Repetitions is -1.
+//
+// The function returns a RepeatingInterval structure or an error if the parsing fails.
+// As with ParseInterval, b is not copied.
+func ParseRepeatingInterval[bytes []byte | ~string](b bytes, opts ...ParseDateTimeOptions) (RepeatingInterval, error) {
+	o := newParseDateTimeOptions(opts)
+	return parseRepeatingInterval(b, &o)
+}
+
+func parseRepeatingInterval[bytes []byte | ~string](b bytes, o *parseDateTimeOptions) (RepeatingInterval, error) {
+	if len(b) == 0 || b[0] != 'R' {
+		return RepeatingInterval{}, &UnexpectedTokenError{
+			Value:    string(b),
+			Token:    string(b),
+			Expected: "R",
+			Length:   len(b),
+			Kind:     tokenKind(b, 0),
+		}
+	}
+	n := countDigits(b, 1)
+	if n > 18 {
+		return RepeatingInterval{}, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      humanizeDigits(n),
+			AfterToken: "R",
+			Expected:   "18 or fewer digits",
+			Offset:     1,
+			Length:     n,
+			Kind:       KindUnexpectedToken,
+		}
+	}
+	repetitions := -1
+	if n > 0 {
+		repetitions = parseNumber(b, 1, n)
+	}
+	i := 1 + n
+	if len(b) <= i || b[i] != '/' {
+		return RepeatingInterval{}, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[i:]),
+			AfterToken: string(b[:i]),
+			Expected:   "/",
+			Offset:     i,
+			Length:     len(b) - i,
+			Kind:       tokenKind(b, i),
+		}
+	}
+	interval, err := parseInterval(b[i+1:], o)
+	if err != nil {
+		return RepeatingInterval{}, overrideErrorPosition(err, b, i+1)
+	}
+	return RepeatingInterval{
+		Repetitions: repetitions,
+		Interval:    interval,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRepeatingInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    RepeatingInterval
+		wantErr error
+	}{
+		{
+			name: "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M",
+			want: RepeatingInterval{
+				Repetitions: 5,
+				Interval: Interval{
+					Start: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC),
+					Duration: Duration{
+						Year:   1,
+						Month:  2,
+						Day:    10,
+						Hour:   2,
+						Minute: 30,
+					},
+				},
+			},
+		},
+		{
+			name: "R/P1D/2024-01-01",
+			want: RepeatingInterval{
+				Repetitions: -1,
+				Interval: Interval{
+					End:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Duration: Duration{Day: 1},
+				},
+			},
+		},
+		{
+			name: "R0/2024-01-01/2024-01-02",
+			want: RepeatingInterval{
+				Repetitions: 0,
+				Interval: Interval{
+					Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "2024-01-01/P1D",
+			wantErr: &UnexpectedTokenError{
+				Value:    "2024-01-01/P1D",
+				Token:    "2024-01-01/P1D",
+				Expected: "R",
+				Length:   14,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+		{
+			name: "R5",
+			wantErr: &UnexpectedTokenError{
+				Value:      "R5",
+				Token:      "",
+				AfterToken: "R5",
+				Expected:   "/",
+				Offset:     2,
+				Kind:       KindUnexpectedEnd,
+			},
+		},
+		{
+			name: "R5X2024-01-01/P1D",
+			wantErr: &UnexpectedTokenError{
+				Value:      "R5X2024-01-01/P1D",
+				Token:      "X2024-01-01/P1D",
+				AfterToken: "R5",
+				Expected:   "/",
+				Offset:     2,
+				Length:     15,
+				Kind:       KindUnexpectedToken,
+			},
+		},
+		{
+			name: "R5/2024-01-01",
+			wantErr: &UnexpectedTokenError{
+				Value:    "R5/2024-01-01",
+				Token:    "2024-01-01",
+				Expected: "'/' or '--' separator",
+				Offset:   3,
+				Length:   10,
+				Kind:     KindUnexpectedToken,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRepeatingInterval(tt.name)
+			if tt.wantErr != nil {
+				if diff := cmp.Diff(tt.wantErr, err); diff != "" {
+					t.Errorf("error: (-want, +got)\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepeatingInterval_String(t *testing.T) {
+	r := RepeatingInterval{
+		Repetitions: 5,
+		Interval: Interval{
+			Start:    time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC),
+			Duration: Duration{Day: 1},
+		},
+	}
+	if want, got := "R5/2008-03-01T13:00:00Z/P1D", r.String(); want != got {
+		t.Errorf("want %q but got %q", want, got)
+	}
+	r.Repetitions = -1
+	if want, got := "R/2008-03-01T13:00:00Z/P1D", r.String(); want != got {
+		t.Errorf("want %q but got %q", want, got)
+	}
+}
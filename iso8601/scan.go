@@ -0,0 +1,283 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// MatchKind represents the kinds of representations found by Scanner.
+// The kinds can be combined with the bitwise OR operator.
+type MatchKind int
+
+const (
+	// MatchDate indicates dates without time, such as "2012-12-24".
+	MatchDate MatchKind = 1 << iota
+	// MatchDateTime indicates combined dates and times, such as "2012-12-24T12:30:45Z".
+	MatchDateTime
+	// MatchDuration indicates durations, such as "P1Y2M10DT2H30M".
+	MatchDuration
+)
+
+// Match represents an ISO 8601 representation found in a text.
+type Match struct {
+	// Start and End are the byte offsets of the representation in the text.
+	// The representation is text[Start:End].
+	Start int
+	End   int
+	Kind  MatchKind
+
+	// Date is the parsed date if Kind is MatchDate.
+	Date DateLike
+	// Time is the parsed date-time if Kind is MatchDateTime. It is the same
+	// as the result of ParseDateTime.
+	Time time.Time
+	// Duration is the parsed duration if Kind is MatchDuration.
+	Duration Duration
+}
+
+type scanOptions struct {
+	kinds MatchKind
+	forms int // the bit set of DateForm
+	basic bool
+	parse parseDateTimeOptions
+}
+
+// ScanOptions is a function type that modifies which representations
+// are found by Scanner. It acts as a functional option.
+type ScanOptions func(*scanOptions)
+
+// WithMatchKinds is an option to change the kinds of representations to find.
+//
+// By default, MatchDate | MatchDateTime | MatchDuration is used.
+func WithMatchKinds(kinds MatchKind) ScanOptions {
+	return func(o *scanOptions) {
+		o.kinds = kinds
+	}
+}
+
+// WithMatchDateForms is an option to change the representations of dates
+// to find. It affects both dates and date-times.
+//
+// By default, the representations defined by ISO 8601, which are
+// CalendarDateForm, OrdinalDateForm and WeekDateForm, are used.
+func WithMatchDateForms(forms ...DateForm) ScanOptions {
+	return func(o *scanOptions) {
+		o.forms = 0
+		for _, form := range forms {
+			o.forms |= 1 << form
+		}
+	}
+}
+
+// WithMatchBasicFormat is an option to find dates in the basic format such
+// as "20121224" and "20121224T123045Z".
+//
+// By default, only dates in the extended format are found because any
+// 7 or 8 digit number may be a date in the basic format.
+func WithMatchBasicFormat() ScanOptions {
+	return func(o *scanOptions) {
+		o.basic = true
+	}
+}
+
+// WithMatchParseOptions is an option to parse dates and date-times with the
+// given options. For example, WithTimeDesignators(' ') finds date-times
+// such as "2012-12-24 12:30:45Z", and WithoutTimeZone skips date-times which
+// have a time zone designator. The dates and date-times which are rejected
+// by ParseDateTime with the options, such as those which do not conform to
+// the profile given by WithProfile, are skipped.
+func WithMatchParseOptions(opts ...ParseDateTimeOptions) ScanOptions {
+	return func(o *scanOptions) {
+		o.parse = newParseDateTimeOptions(opts)
+	}
+}
+
+// Scanner finds ISO 8601 dates, date-times and durations embedded in a text.
+// Successive calls to the Scan method step through the representations in
+// the text from the beginning. For example:
+//
+//	s := iso8601.NewScanner(text)
+//	for s.Scan() {
+//		m := s.Match()
+//		fmt.Println(text[m.Start:m.End], m.Kind)
+//	}
+//
+// A representation is found only if it is neither preceded nor followed by
+// an ASCII letter, digit or underscore.
+type Scanner[bytes []byte | ~string] struct {
+	text  bytes
+	pos   int
+	match Match
+	o     scanOptions
+}
+
+// NewScanner returns a new Scanner to find representations in text.
+func NewScanner[bytes []byte | ~string](text bytes, opts ...ScanOptions) *Scanner[bytes] {
+	s := &Scanner[bytes]{
+		text: text,
+		o: scanOptions{
+			kinds: MatchDate | MatchDateTime | MatchDuration,
+			forms: 1<<CalendarDateForm | 1<<OrdinalDateForm | 1<<WeekDateForm,
+			parse: defaultParseDateTimeOptions,
+		},
+	}
+	for _, opt := range opts {
+		opt(&s.o)
+	}
+	return s
+}
+
+// Scan advances the Scanner to the next representation, which will then be
+// available through the Match method. It returns false when there are no
+// more representations in the text.
+func (s *Scanner[bytes]) Scan() bool {
+	for s.pos < len(s.text) {
+		i := s.pos
+		if i > 0 && isWordByte(s.text[i-1]) {
+			s.pos++
+			continue
+		}
+		m, ok := s.matchAt(i)
+		if !ok {
+			s.pos++
+			continue
+		}
+		// Skip the whole representation even if the kind is not wanted
+		// so that a part of it is not found.
+		s.pos = m.End
+		if s.o.kinds&m.Kind != 0 {
+			s.match = m
+			return true
+		}
+	}
+	return false
+}
+
+// Match returns the most recent representation found by a call to Scan.
+func (s *Scanner[bytes]) Match() Match {
+	return s.match
+}
+
+// FindAll returns all the ISO 8601 representations in text found by Scanner.
+// It returns nil if there is no representation.
+func FindAll[bytes []byte | ~string](text bytes, opts ...ScanOptions) []Match {
+	var matches []Match
+	s := NewScanner(text, opts...)
+	for s.Scan() {
+		matches = append(matches, s.Match())
+	}
+	return matches
+}
+
+func (s *Scanner[bytes]) matchAt(i int) (Match, bool) {
+	b := s.text[i:]
+	switch c := b[0]; {
+	case c == 'P' || ((c == '+' || c == '-') && len(b) > 1 && b[1] == 'P'):
+		return s.matchDuration(i)
+	case isDigit(c) || c == '+' || (c == '-' && s.o.parse.expandedYear > 0):
+		return s.matchDateTime(i)
+	}
+	return Match{}, false
+}
+
+// matchDateTime finds the date or the date-time which starts at i.
+func (s *Scanner[bytes]) matchDateTime(i int) (Match, bool) {
+	b := s.text[i:]
+	if signed := len(b) - len(trimSign(b)); countDigits(b, signed) < 4 {
+		return Match{}, false
+	}
+	n, d, err := parseDate(b, s.o.parse.expandedYear)
+	if err != nil || s.o.forms&(1<<d.form) == 0 {
+		return Match{}, false
+	}
+	if !s.o.basic && indexByte(trimSign(b[:n]), '-') < 0 {
+		return Match{}, false
+	}
+
+	if n < len(b) && s.o.parse.timeDesignators.contains(b[n]) {
+		if nt, _, err := parseTime(b[n+1:]); err == nil {
+			end := n + 1 + nt
+			if end < len(b) && (b[end] == 'Z' || b[end] == '+' || b[end] == '-') {
+				if nz, _, err := parseZone(b[end:]); err == nil {
+					end += nz
+				}
+			}
+			if !isWordBoundary(b, end) {
+				return Match{}, false
+			}
+			// The date-time is assembled and checked for the options
+			// such as the profile in the same way as ParseDateTime.
+			result, err := parseDateTime(b[:end], &s.o.parse)
+			if err != nil {
+				return Match{}, false
+			}
+			return Match{
+				Start: i,
+				End:   i + end,
+				Kind:  MatchDateTime,
+				Time:  result,
+			}, true
+		}
+	}
+
+	if !isWordBoundary(b, n) {
+		return Match{}, false
+	}
+	if _, err := parseDateTime(b[:n], &s.o.parse); err != nil {
+		return Match{}, false
+	}
+	return Match{
+		Start: i,
+		End:   i + n,
+		Kind:  MatchDate,
+		Date:  d.dateLike(),
+	}, true
+}
+
+// matchDuration finds the duration which starts at i.
+func (s *Scanner[bytes]) matchDuration(i int) (Match, bool) {
+	b := s.text[i:]
+	n := len(b) - len(trimSign(b)) + 1 // 1 == 'P'
+	for n < len(b) && (isDigit(b[n]) || indexByte("YMWDTHS.,", b[n]) >= 0) {
+		n++
+	}
+	// The duration ends with the designator of the smallest unit.
+	for n > 0 && indexByte("YMWDHS", b[n-1]) < 0 {
+		n--
+	}
+	if n == 0 || !isWordBoundary(b, n) {
+		return Match{}, false
+	}
+	d, err := parseDuration(b[:n])
+	if err != nil {
+		return Match{}, false
+	}
+	return Match{
+		Start:    i,
+		End:      i + n,
+		Kind:     MatchDuration,
+		Duration: d,
+	}, true
+}
+
+func trimSign[bytes []byte | ~string](b bytes) bytes {
+	if len(b) > 0 && (b[0] == '+' || b[0] == '-') {
+		return b[1:]
+	}
+	return b
+}
+
+// isWordBoundary reports whether b ends at i or b[i] is not a word byte.
+func isWordBoundary[bytes []byte | ~string](b bytes, i int) bool {
+	return i >= len(b) || !isWordByte(b[i])
+}
+
+// isWordByte reports whether c is an ASCII letter, digit or underscore.
+// The bytes of non-ASCII characters are not word bytes, so that
+// representations next to such as Japanese characters are found.
+func isWordByte(c byte) bool {
+	return isDigit(c) || c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
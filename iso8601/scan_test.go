@@ -0,0 +1,173 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindAll(t *testing.T) {
+	jst := time.FixedZone("", 9*3600)
+	tests := []struct {
+		name string
+		text string
+		opts []ScanOptions
+		want []Match
+	}{
+		{
+			name: "date and date-time and duration",
+			text: "Released on 2012-12-24, patched at 2012-12-25T09:30:00+09:00 and supported for P1Y6M.",
+			want: []Match{
+				{Start: 12, End: 22, Kind: MatchDate, Date: Date{Year: 2012, Month: 12, Day: 24}},
+				{Start: 35, End: 60, Kind: MatchDateTime, Time: time.Date(2012, 12, 25, 9, 30, 0, 0, jst)},
+				{Start: 79, End: 84, Kind: MatchDuration, Duration: Duration{Year: 1, Month: 6}},
+			},
+		},
+		{
+			name: "week and ordinal dates",
+			text: "(2012-W52-1) [2012-359]",
+			want: []Match{
+				{Start: 1, End: 11, Kind: MatchDate, Date: WeekDate{Year: 2012, Week: 52, Day: 1}},
+				{Start: 14, End: 22, Kind: MatchDate, Date: OrdinalDate{Year: 2012, Day: 359}},
+			},
+		},
+		{
+			name: "next to non-ASCII characters",
+			text: "期限は2024-03-01T17:00Zです",
+			want: []Match{
+				{Start: 9, End: 26, Kind: MatchDateTime, Time: time.Date(2024, 3, 1, 17, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "not a word",
+			text: "v2024-03-01 2024-03-01x x2012-12-24T09:30Z PDF PT 12345-03-01 2024-13-01",
+		},
+		{
+			name: "date followed by the designator without time",
+			text: "2024-03-01Tuesday",
+		},
+		{
+			name: "invalid zone is not a part of date-time",
+			text: "2024-03-01T09:30+9",
+			opts: []ScanOptions{WithMatchParseOptions(WithInLocation(time.UTC))},
+			want: []Match{
+				{Start: 0, End: 16, Kind: MatchDateTime, Time: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "basic format is ignored by default",
+			text: "20121224 20121224T093000Z",
+		},
+		{
+			name: "basic format",
+			text: "20121224 20121224T093000Z",
+			opts: []ScanOptions{WithMatchBasicFormat()},
+			want: []Match{
+				{Start: 0, End: 8, Kind: MatchDate, Date: Date{Year: 2012, Month: 12, Day: 24}},
+				{Start: 9, End: 25, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "quarter dates are ignored by default",
+			text: "2012-Q4-85",
+		},
+		{
+			name: "date forms",
+			text: "2012-Q4-85 2012-12-24",
+			opts: []ScanOptions{WithMatchDateForms(QuarterDateForm)},
+			want: []Match{
+				{Start: 0, End: 10, Kind: MatchDate, Date: QuarterDate{Year: 2012, Quarter: 4, Day: 85}},
+			},
+		},
+		{
+			name: "kinds",
+			text: "2012-12-24 2012-12-24T09:30Z -P1D",
+			opts: []ScanOptions{WithMatchKinds(MatchDate | MatchDuration)},
+			want: []Match{
+				{Start: 0, End: 10, Kind: MatchDate, Date: Date{Year: 2012, Month: 12, Day: 24}},
+				{Start: 29, End: 33, Kind: MatchDuration, Duration: Duration{Day: 1, Negative: true}},
+			},
+		},
+		{
+			name: "space designator",
+			text: "at 2012-12-24 09:30:15.5Z, 2012-12-24 and",
+			opts: []ScanOptions{WithMatchParseOptions(WithTimeDesignators(' '))},
+			want: []Match{
+				{Start: 3, End: 25, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 15, 500000000, time.UTC)},
+				{Start: 27, End: 37, Kind: MatchDate, Date: Date{Year: 2012, Month: 12, Day: 24}},
+			},
+		},
+		{
+			name: "without time zone",
+			text: "2012-12-24T09:30Z 2012-12-24T09:30",
+			opts: []ScanOptions{WithMatchParseOptions(WithoutTimeZone(), WithInLocation(time.UTC))},
+			want: []Match{
+				{Start: 18, End: 34, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "RFC 3339 profile",
+			text: "2012-12-24t09:30:15+09:00 2012-12-24T09:30Z 2012-W52-1T09:30:15Z 20121224T093015Z 2012-12-24 2012-12-24T09:30:15Z",
+			opts: []ScanOptions{
+				WithMatchBasicFormat(),
+				WithMatchParseOptions(WithProfile(RFC3339Profile)),
+			},
+			want: []Match{
+				{Start: 0, End: 25, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 15, 0, jst)},
+				{Start: 93, End: 113, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 15, 0, time.UTC)},
+			},
+		},
+		{
+			name: "XML Schema profile",
+			text: "2012-12-24T09:30:15+15:00 2012-12-24T09:30:15 2012-12-24",
+			opts: []ScanOptions{WithMatchParseOptions(WithProfile(XMLSchemaProfile), WithInLocation(time.UTC))},
+			want: []Match{
+				{Start: 26, End: 45, Kind: MatchDateTime, Time: time.Date(2012, 12, 24, 9, 30, 15, 0, time.UTC)},
+			},
+		},
+		{
+			name: "expanded year",
+			text: "from -000044-03-15 to +002024-03-01",
+			opts: []ScanOptions{WithMatchParseOptions(WithExpandedYear(2))},
+			want: []Match{
+				{Start: 5, End: 18, Kind: MatchDate, Date: Date{Year: -44, Month: 3, Day: 15}},
+				{Start: 22, End: 35, Kind: MatchDate, Date: Date{Year: 2024, Month: 3, Day: 1}},
+			},
+		},
+		{
+			name: "durations",
+			text: "PT1.5S, P3W. +P1Y2M10DT2H30M",
+			want: []Match{
+				{Start: 0, End: 6, Kind: MatchDuration, Duration: Duration{Second: 1, Millisecond: 500}},
+				{Start: 8, End: 11, Kind: MatchDuration, Duration: Duration{Week: 3}},
+				{Start: 13, End: 28, Kind: MatchDuration, Duration: Duration{Year: 1, Month: 2, Day: 10, Hour: 2, Minute: 30}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindAll(tt.text, tt.opts...)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanner(t *testing.T) {
+	text := []byte("2012-12-24T09:30Z/2012-12-25T09:30Z")
+	s := NewScanner(text)
+	var got []string
+	for s.Scan() {
+		m := s.Match()
+		got = append(got, string(text[m.Start:m.End]))
+	}
+	want := []string{"2012-12-24T09:30Z", "2012-12-25T09:30Z"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	if s.Scan() {
+		t.Error("want false after the end of the text")
+	}
+}
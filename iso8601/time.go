@@ -63,18 +63,36 @@ func (t Time) Validate() error {
 }
 
 // TimeRangeError indicates that a value is not in an expected range for Time.
+//
+// If the error is returned by the parsing functions, Offset and Length are
+// the position of the element in the parsed value in bytes.
 type TimeRangeError struct {
 	Element string
 	Value   int
 	Min     int
 	Max     int
+
+	Offset int
+	Length int
 }
 
+var _ PositionError = (*TimeRangeError)(nil)
+
 // Error implements the error interface.
 func (e *TimeRangeError) Error() string {
 	return fmt.Sprintf("iso8601 time: %d %s is not in range %d-%d", e.Value, e.Element, e.Min, e.Max)
 }
 
+// Position implements the PositionError interface.
+func (e *TimeRangeError) Position() (offset, length int) {
+	return e.Offset, e.Length
+}
+
+// ErrorKind implements the PositionError interface. It always returns KindOutOfRange.
+func (e *TimeRangeError) ErrorKind() ErrorKind {
+	return KindOutOfRange
+}
+
 // ParseTime attempts to parse a given byte slice representing a time in
 // various supported ISO 8601 formats. Supported formats include:
 //
@@ -90,8 +108,9 @@ func (e *TimeRangeError) Error() string {
 //	123045,123456789   12:30:45,123456789
 //
 // The function returns a Time structure or an error if the parsing fails.
+// b is not copied, and no allocation happens on success.
 func ParseTime[bytes []byte | ~string](b bytes) (Time, error) {
-	n, t, err := parseTime([]byte(b))
+	n, t, err := parseTime(b)
 	if err != nil {
 		return Time{}, err
 	}
@@ -101,12 +120,15 @@ func ParseTime[bytes []byte | ~string](b bytes) (Time, error) {
 			Token:      string(b[n:]),
 			AfterToken: string(b[:n]),
 			Expected:   string(b[:n]),
+			Offset:     n,
+			Length:     len(b) - n,
+			Kind:       KindExtraText,
 		}
 	}
 	return t, nil
 }
 
-func parseTime(b []byte) (int, Time, error) {
+func parseTime[bytes []byte | ~string](b bytes) (int, Time, error) {
 	if len(b) > 2 && b[2] == ':' {
 		return parseExtendedTime(b)
 	}
@@ -124,7 +146,7 @@ func parseTime(b []byte) (int, Time, error) {
  *  hh:mm:ss.fffffffff
  *  hh:mm:ss,fffffffff
  */
-func parseExtendedTime(b []byte) (int, Time, error) {
+func parseExtendedTime[bytes []byte | ~string](b bytes) (int, Time, error) {
 	var (
 		h    int
 		m    int
@@ -146,6 +168,8 @@ func parseExtendedTime(b []byte) (int, Time, error) {
 			Value:    string(b),
 			Token:    humanizeDigits(c),
 			Expected: humanizeDigits(2),
+			Length:   tokenLength(b, 0, c),
+			Kind:     tokenKind(b, 0),
 		}
 	}
 
@@ -153,7 +177,7 @@ func parseExtendedTime(b []byte) (int, Time, error) {
 	if len(b) < 3 || b[2] != ':' {
 		nsec, n := parseFractionIfPresent(2)
 		nsec *= 3600 // hour
-		t, err := hmsfTime(h, m, s, nsec)
+		t, err := hmsfTime(h, m, s, nsec, 1)
 		return n, t, err
 	}
 
@@ -163,6 +187,9 @@ func parseExtendedTime(b []byte) (int, Time, error) {
 			AfterToken: string(b[:3]),
 			Token:      humanizeDigits(c),
 			Expected:   humanizeDigits(2),
+			Offset:     3,
+			Length:     tokenLength(b, 3, c),
+			Kind:       tokenKind(b, 3),
 		}
 	}
 
@@ -170,7 +197,7 @@ func parseExtendedTime(b []byte) (int, Time, error) {
 	if len(b) < 6 || b[5] != ':' {
 		nsec, n := parseFractionIfPresent(5)
 		nsec *= 60 // hour
-		t, err := hmsfTime(h, m, s, nsec)
+		t, err := hmsfTime(h, m, s, nsec, 1)
 		return n, t, err
 	}
 
@@ -180,12 +207,15 @@ func parseExtendedTime(b []byte) (int, Time, error) {
 			AfterToken: string(b[:6]),
 			Token:      humanizeDigits(c),
 			Expected:   humanizeDigits(2),
+			Offset:     6,
+			Length:     tokenLength(b, 6, c),
+			Kind:       tokenKind(b, 6),
 		}
 	}
 
 	s = parseNumber(b, 6, 2)
 	nsec, n := parseFractionIfPresent(8)
-	t, err := hmsfTime(h, m, s, nsec)
+	t, err := hmsfTime(h, m, s, nsec, 1)
 	return n, t, err
 }
 
@@ -200,7 +230,7 @@ func parseExtendedTime(b []byte) (int, Time, error) {
  *  hhmmss.fffffffff
  *  hhmmss,fffffffff
  */
-func parseBasicTime(b []byte) (int, Time, error) {
+func parseBasicTime[bytes []byte | ~string](b bytes) (int, Time, error) {
 	var (
 		h    int
 		m    int
@@ -233,6 +263,8 @@ func parseBasicTime(b []byte) (int, Time, error) {
 			Token:      humanizeDigits(n),
 			AfterToken: afterToken,
 			Expected:   humanizeDigits(2),
+			Length:     tokenLength(b, 0, n),
+			Kind:       tokenKind(b, 0),
 		}
 	}
 
@@ -253,11 +285,11 @@ func parseBasicTime(b []byte) (int, Time, error) {
 		n += digits + 1 // 1 == '.' or ','
 	}
 
-	t, err := hmsfTime(h, m, s, nsec)
+	t, err := hmsfTime(h, m, s, nsec, 0)
 	return n, t, err
 }
 
-func parseFraction(b []byte) (int, int) {
+func parseFraction[bytes []byte | ~string](b bytes) (int, int) {
 	n := countDigits(b, 0)
 	digits := n
 	if digits > 9 {
@@ -266,9 +298,11 @@ func parseFraction(b []byte) (int, int) {
 	return parseNumber(b, 0, digits) * int(math.Pow10(9-digits)), n
 }
 
-func hmsfTime(h, m, s, f int) (Time, error) {
-	s += int(math.Trunc(float64(f)/1e9)) % 60
-	m += int(math.Trunc(float64(f) / 60e9))
+// hmsfTime returns the Time. sep is the length of the separator between
+// the elements, which is used for the position of the range error.
+func hmsfTime(h, m, s, f int, sep int) (Time, error) {
+	s += f / 1e9 % 60
+	m += f / 60e9
 	t := Time{
 		Hour:       h,
 		Minute:     m,
@@ -276,6 +310,16 @@ func hmsfTime(h, m, s, f int) (Time, error) {
 		Nanosecond: f % int(1e9),
 	}
 	if err := t.Validate(); err != nil {
+		if e, ok := err.(*TimeRangeError); ok {
+			switch e.Element {
+			case "hour":
+				e.Offset, e.Length = 0, 2
+			case "minute":
+				e.Offset, e.Length = 2+sep, 2
+			case "second":
+				e.Offset, e.Length = 4+2*sep, 2
+			}
+		}
 		return Time{}, err
 	}
 	return t, nil
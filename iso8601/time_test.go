@@ -356,6 +356,8 @@ func TestParseTime(t *testing.T) {
 				Value:    "0",
 				Token:    humanizeDigits(1),
 				Expected: humanizeDigits(2),
+				Length:   1,
+				Kind:     KindUnexpectedToken,
 			},
 		},
 		{
@@ -365,6 +367,8 @@ func TestParseTime(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "01",
 				Expected:   humanizeDigits(2),
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -374,6 +378,8 @@ func TestParseTime(t *testing.T) {
 				Token:      humanizeDigits(5),
 				AfterToken: "0123",
 				Expected:   humanizeDigits(2),
+				Length:     5,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -383,6 +389,8 @@ func TestParseTime(t *testing.T) {
 				Token:      humanizeDigits(7),
 				AfterToken: "0123",
 				Expected:   humanizeDigits(2),
+				Length:     7,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -392,6 +400,9 @@ func TestParseTime(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "01:",
 				Expected:   humanizeDigits(2),
+				Offset:     3,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -401,6 +412,9 @@ func TestParseTime(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "01:12:",
 				Expected:   humanizeDigits(2),
+				Offset:     6,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -410,6 +424,9 @@ func TestParseTime(t *testing.T) {
 				Token:      "hello",
 				AfterToken: "235959",
 				Expected:   "235959",
+				Offset:     6,
+				Length:     5,
+				Kind:       KindExtraText,
 			},
 		},
 		{
@@ -419,6 +436,9 @@ func TestParseTime(t *testing.T) {
 				Token:      "hello",
 				AfterToken: "23:59:59",
 				Expected:   "23:59:59",
+				Offset:     8,
+				Length:     5,
+				Kind:       KindExtraText,
 			},
 		},
 		// invalid time range
@@ -429,6 +449,7 @@ func TestParseTime(t *testing.T) {
 				Value:   24,
 				Min:     0,
 				Max:     24,
+				Length:  2,
 			},
 		},
 		{
@@ -438,6 +459,8 @@ func TestParseTime(t *testing.T) {
 				Value:   60,
 				Min:     0,
 				Max:     59,
+				Offset:  2,
+				Length:  2,
 			},
 		},
 		{
@@ -447,6 +470,8 @@ func TestParseTime(t *testing.T) {
 				Value:   60,
 				Min:     0,
 				Max:     59,
+				Offset:  4,
+				Length:  2,
 			},
 		},
 		{
@@ -456,6 +481,7 @@ func TestParseTime(t *testing.T) {
 				Value:   25,
 				Min:     0,
 				Max:     24,
+				Length:  2,
 			},
 		},
 		{
@@ -465,6 +491,7 @@ func TestParseTime(t *testing.T) {
 				Value:   24,
 				Min:     0,
 				Max:     24,
+				Length:  2,
 			},
 		},
 		{
@@ -474,6 +501,8 @@ func TestParseTime(t *testing.T) {
 				Value:   60,
 				Min:     0,
 				Max:     59,
+				Offset:  3,
+				Length:  2,
 			},
 		},
 		{
@@ -483,6 +512,8 @@ func TestParseTime(t *testing.T) {
 				Value:   60,
 				Min:     0,
 				Max:     59,
+				Offset:  6,
+				Length:  2,
 			},
 		},
 	}
@@ -533,6 +564,8 @@ func Test_parseExtendedTime(t *testing.T) {
 				Value:    "0",
 				Token:    humanizeDigits(1),
 				Expected: humanizeDigits(2),
+				Length:   1,
+				Kind:     KindUnexpectedToken,
 			},
 		},
 		{
@@ -541,6 +574,8 @@ func Test_parseExtendedTime(t *testing.T) {
 				Value:    "123",
 				Token:    humanizeDigits(3),
 				Expected: humanizeDigits(2),
+				Length:   3,
+				Kind:     KindUnexpectedToken,
 			},
 		},
 	}
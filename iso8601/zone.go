@@ -61,19 +61,37 @@ func (z Zone) Offset() int {
 	return sign * (z.Hour*3600 + z.Minute*60 + z.Second)
 }
 
-// TimeRangeError indicates that a value is not in an expected range for Time.
+// TimeZoneRangeError indicates that a value is not in an expected range for Zone.
+//
+// If the error is returned by the parsing functions, Offset and Length are
+// the position of the time zone designator in the parsed value in bytes.
 type TimeZoneRangeError struct {
 	Element string
 	Value   int
 	Min     int
 	Max     int
+
+	Offset int
+	Length int
 }
 
+var _ PositionError = (*TimeZoneRangeError)(nil)
+
 // Error implements the error interface.
 func (e *TimeZoneRangeError) Error() string {
 	return fmt.Sprintf("iso8601 time zone: %d %s is not in range %d-%d", e.Value, e.Element, e.Min, e.Max)
 }
 
+// Position implements the PositionError interface.
+func (e *TimeZoneRangeError) Position() (offset, length int) {
+	return e.Offset, e.Length
+}
+
+// ErrorKind implements the PositionError interface. It always returns KindOutOfRange.
+func (e *TimeZoneRangeError) ErrorKind() ErrorKind {
+	return KindOutOfRange
+}
+
 // ParseZone attempts to parse a given byte slice representing a timezone offset
 // in various supported ISO 8601 formats. Supported formats include:
 //
@@ -84,11 +102,44 @@ func (e *TimeZoneRangeError) Error() string {
 //	±hhmmss     ±hh:mm:ss
 //
 // The function returns a Zone structure or an error if the parsing fails.
+// b is not copied, and no allocation happens on success.
 func ParseZone[bytes []byte | ~string](b bytes) (Zone, error) {
+	n, z, err := parseZone(b)
+	if err != nil {
+		return Zone{}, err
+	}
+	if len(b) != n {
+		if b[0] == 'Z' {
+			return Zone{}, &UnexpectedTokenError{
+				Value:      string(b),
+				Token:      string(b[1:]),
+				AfterToken: "Z",
+				Expected:   fmt.Sprintf("non extra token (%s)", b[1:]),
+				Offset:     1,
+				Length:     len(b) - 1,
+				Kind:       KindExtraText,
+			}
+		}
+		return Zone{}, &UnexpectedTokenError{
+			Value:      string(b),
+			Token:      string(b[n:]),
+			AfterToken: string(b[:n]),
+			Expected:   string(b[:n]),
+			Offset:     n,
+			Length:     len(b) - n,
+			Kind:       KindExtraText,
+		}
+	}
+	return z, nil
+}
+
+// parseZone parses the time zone designator at the beginning of b and
+// returns the length of it.
+func parseZone[bytes []byte | ~string](b bytes) (int, Zone, error) {
 	if len(b) > 3 && b[3] == ':' {
-		return parseExtendedZone([]byte(b))
+		return parseExtendedZone(b)
 	}
-	return parseBasicZone([]byte(b))
+	return parseBasicZone(b)
 }
 
 /*
@@ -97,7 +148,7 @@ func ParseZone[bytes []byte | ~string](b bytes) (Zone, error) {
  *  ±hh:mm
  *  ±hh:mm:ss
  */
-func parseExtendedZone(b []byte) (Zone, error) {
+func parseExtendedZone[bytes []byte | ~string](b bytes) (int, Zone, error) {
 	var (
 		h        int
 		m        int
@@ -105,74 +156,78 @@ func parseExtendedZone(b []byte) (Zone, error) {
 		negative bool
 	)
 	if len(b) == 0 {
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "Z or + or -",
+			Kind:       KindUnexpectedEnd,
 		}
 	}
 	switch b[0] {
 	case 'Z':
-		if len(b) > 1 {
-			return Zone{}, &UnexpectedTokenError{
-				Value:      string(b),
-				Token:      string(b[1:]),
-				AfterToken: "Z",
-				Expected:   fmt.Sprintf("non extra token (%s)", b[1:]),
-			}
-		}
-		return timeZone(0, 0, 0, false)
+		return timeZone(1, 0, 0, 0, false)
 	case '+':
 	case '-':
 		negative = true
 	default:
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "Z or + or -",
+			Length:     len(b),
+			Kind:       KindUnexpectedToken,
 		}
 	}
 
 	if c := countDigits(b, 1); c != 2 {
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:    string(b),
 			Token:    humanizeDigits(c),
 			Expected: humanizeDigits(2),
+			Offset:   1,
+			Length:   tokenLength(b, 1, c),
+			Kind:     tokenKind(b, 1),
 		}
 	}
 
 	h = parseNumber(b, 1, 2)
 	if len(b) < 4 || b[3] != ':' {
-		return timeZone(h, m, s, negative)
+		return timeZone(3, h, m, s, negative)
 	}
 
 	if c := countDigits(b, 4); c != 2 {
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			AfterToken: string(b[:4]),
 			Token:      humanizeDigits(c),
 			Expected:   humanizeDigits(2),
+			Offset:     4,
+			Length:     tokenLength(b, 4, c),
+			Kind:       tokenKind(b, 4),
 		}
 	}
 
 	m = parseNumber(b, 4, 2)
 	if len(b) < 7 || b[6] != ':' {
-		return timeZone(h, m, s, negative)
+		return timeZone(6, h, m, s, negative)
 	}
 
 	if c := countDigits(b, 7); c != 2 {
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			AfterToken: string(b[:7]),
 			Token:      humanizeDigits(c),
 			Expected:   humanizeDigits(2),
+			Offset:     7,
+			Length:     tokenLength(b, 7, c),
+			Kind:       tokenKind(b, 7),
 		}
 	}
 
 	s = parseNumber(b, 7, 2)
-	return timeZone(h, m, s, negative)
+	return timeZone(9, h, m, s, negative)
 }
 
 /*
@@ -181,7 +236,7 @@ func parseExtendedZone(b []byte) (Zone, error) {
  *  ±hhmm
  *  ±hhmmss
  */
-func parseBasicZone(b []byte) (Zone, error) {
+func parseBasicZone[bytes []byte | ~string](b bytes) (int, Zone, error) {
 	var (
 		h        int
 		m        int
@@ -189,33 +244,28 @@ func parseBasicZone(b []byte) (Zone, error) {
 		negative bool
 	)
 	if len(b) == 0 {
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "Z or + or -",
+			Kind:       KindUnexpectedEnd,
 		}
 	}
 	switch b[0] {
 	case 'Z':
-		if len(b) > 1 {
-			return Zone{}, &UnexpectedTokenError{
-				Value:      string(b),
-				Token:      string(b[1:]),
-				AfterToken: "Z",
-				Expected:   fmt.Sprintf("non extra token (%s)", b[1:]),
-			}
-		}
-		return timeZone(0, 0, 0, false)
+		return timeZone(1, 0, 0, 0, false)
 	case '+':
 	case '-':
 		negative = true
 	default:
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      string(b),
 			AfterToken: "",
 			Expected:   "Z or + or -",
+			Length:     len(b),
+			Kind:       KindUnexpectedToken,
 		}
 	}
 
@@ -223,18 +273,18 @@ func parseBasicZone(b []byte) (Zone, error) {
 	switch n {
 	case 2: // ±hh
 		h = parseNumber(b, 1, 2)
-		return timeZone(h, m, s, negative)
+		return timeZone(3, h, m, s, negative)
 	case 4: // ±hhmm
 		h = parseNumber(b, 1, 2)
 		m = parseNumber(b, 3, 2)
-		return timeZone(h, m, s, negative)
+		return timeZone(5, h, m, s, negative)
 	case 6: // ±hhmmss
 		h = parseNumber(b, 1, 2)
 		m = parseNumber(b, 3, 2)
 		s = parseNumber(b, 5, 2)
-		return timeZone(h, m, s, negative)
+		return timeZone(7, h, m, s, negative)
 	default:
-		return Zone{}, &UnexpectedTokenError{
+		return 0, Zone{}, &UnexpectedTokenError{
 			Value:      string(b),
 			Token:      humanizeDigits(n),
 			AfterToken: string(b[0]),
@@ -244,11 +294,15 @@ func parseBasicZone(b []byte) (Zone, error) {
 				humanizeDigits(4),
 				humanizeDigits(6),
 			),
+			Offset: 1,
+			Length: tokenLength(b, 1, n),
+			Kind:   tokenKind(b, 1),
 		}
 	}
 }
 
-func timeZone(h, m, s int, minus bool) (Zone, error) {
+// timeZone returns the Zone with n, the length of the parsed designator.
+func timeZone(n, h, m, s int, minus bool) (int, Zone, error) {
 	z := Zone{
 		Hour:     h,
 		Minute:   m,
@@ -256,7 +310,7 @@ func timeZone(h, m, s int, minus bool) (Zone, error) {
 		Negative: minus,
 	}
 	if err := z.Validate(); err != nil {
-		return Zone{}, err
+		return 0, Zone{}, err
 	}
-	return z, nil
+	return n, z, nil
 }
@@ -293,6 +293,7 @@ func TestParseZone(t *testing.T) {
 				Token:      "",
 				AfterToken: "",
 				Expected:   "Z or + or -",
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
@@ -302,6 +303,33 @@ func TestParseZone(t *testing.T) {
 				Token:      "12",
 				AfterToken: "Z",
 				Expected:   "non extra token (12)",
+				Offset:     1,
+				Length:     2,
+				Kind:       KindExtraText,
+			},
+		},
+		{
+			name: "+09:00abc",
+			wantErr: &UnexpectedTokenError{
+				Value:      "+09:00abc",
+				Token:      "abc",
+				AfterToken: "+09:00",
+				Expected:   "+09:00",
+				Offset:     6,
+				Length:     3,
+				Kind:       KindExtraText,
+			},
+		},
+		{
+			name: "+0900:00",
+			wantErr: &UnexpectedTokenError{
+				Value:      "+0900:00",
+				Token:      ":00",
+				AfterToken: "+0900",
+				Expected:   "+0900",
+				Offset:     5,
+				Length:     3,
+				Kind:       KindExtraText,
 			},
 		},
 		{
@@ -311,6 +339,8 @@ func TestParseZone(t *testing.T) {
 				Token:      "X",
 				AfterToken: "",
 				Expected:   "Z or + or -",
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -325,6 +355,9 @@ func TestParseZone(t *testing.T) {
 					humanizeDigits(4),
 					humanizeDigits(6),
 				),
+				Offset: 1,
+				Length: 3,
+				Kind:   KindUnexpectedToken,
 			},
 		},
 		{
@@ -339,6 +372,9 @@ func TestParseZone(t *testing.T) {
 					humanizeDigits(4),
 					humanizeDigits(6),
 				),
+				Offset: 1,
+				Length: 5,
+				Kind:   KindUnexpectedToken,
 			},
 		},
 		{
@@ -348,6 +384,9 @@ func TestParseZone(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "+00:",
 				Expected:   humanizeDigits(2),
+				Offset:     4,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -357,6 +396,9 @@ func TestParseZone(t *testing.T) {
 				Token:      humanizeDigits(3),
 				AfterToken: "-00:00:",
 				Expected:   humanizeDigits(2),
+				Offset:     7,
+				Length:     3,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 	}
@@ -399,16 +441,13 @@ func Test_parseExtendedZone(t *testing.T) {
 				Token:      "",
 				AfterToken: "",
 				Expected:   "Z or + or -",
+				Kind:       KindUnexpectedEnd,
 			},
 		},
 		{
+			// The extra text is reported by ParseZone.
 			name: "Z12",
-			wantErr: &UnexpectedTokenError{
-				Value:      "Z12",
-				Token:      "12",
-				AfterToken: "Z",
-				Expected:   "non extra token (12)",
-			},
+			want: Zone{},
 		},
 		{
 			name: "X",
@@ -417,6 +456,8 @@ func Test_parseExtendedZone(t *testing.T) {
 				Token:      "X",
 				AfterToken: "",
 				Expected:   "Z or + or -",
+				Length:     1,
+				Kind:       KindUnexpectedToken,
 			},
 		},
 		{
@@ -425,12 +466,15 @@ func Test_parseExtendedZone(t *testing.T) {
 				Value:    "-123",
 				Token:    humanizeDigits(3),
 				Expected: humanizeDigits(2),
+				Offset:   1,
+				Length:   3,
+				Kind:     KindUnexpectedToken,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseExtendedZone([]byte(tt.name))
+			_, got, err := parseExtendedZone([]byte(tt.name))
 			if tt.wantErr != nil {
 				if diff := cmp.Diff(tt.wantErr, err); diff != "" {
 					t.Errorf("error: (-want, +got)\n%s", diff)
@@ -445,7 +489,7 @@ func Test_parseExtendedZone(t *testing.T) {
 }
 
 func Test_timeZone(t *testing.T) {
-	got, err := timeZone(1000, 0, 0, false)
+	_, got, err := timeZone(3, 1000, 0, 0, false)
 	if err == nil {
 		t.Fatal("unexpected err is nil")
 	}
@@ -0,0 +1,50 @@
+package synchro
+
+// Iterator is a lazily evaluated sequence of Time[T].
+// It may be unbounded.
+//
+//	it := r.Occurrences()
+//	for it.Next() {
+//		fmt.Println(it.Time())
+//	}
+type Iterator[T TimeZone] struct {
+	next func() (Time[T], bool)
+	cur  Time[T]
+	done bool
+}
+
+func newIterator[T TimeZone](next func() (Time[T], bool)) *Iterator[T] {
+	return &Iterator[T]{next: next}
+}
+
+// Next advances the iterator to the next Time[T], which will then be
+// available through the Time method. It returns false when the iteration
+// stops by reaching the end of the sequence.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	t, ok := it.next()
+	if !ok {
+		it.done = true
+		it.cur = Time[T]{}
+		return false
+	}
+	it.cur = t
+	return true
+}
+
+// Time returns the most recent Time[T] generated by a call to Next.
+func (it *Iterator[T]) Time() Time[T] {
+	return it.cur
+}
+
+// Take returns at most n Time[T] values from the iterator.
+// It is useful to consume an unbounded iterator.
+func (it *Iterator[T]) Take(n int) []Time[T] {
+	var ts []Time[T]
+	for len(ts) < n && it.Next() {
+		ts = append(ts, it.Time())
+	}
+	return ts
+}
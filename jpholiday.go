@@ -0,0 +1,308 @@
+package synchro
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// JapaneseHolidays is a HolidayCalendar of the national holidays in Japan,
+// which are defined by the Act on National Holidays (国民の祝日に関する法律).
+//
+// The holidays are calculated by the rules, including the historical changes
+// since the act took effect on July 20, 1948:
+//
+//   - National holidays on fixed dates and on the n-th Monday (Happy Monday System).
+//   - Vernal and autumnal equinox days, which are computed astronomically.
+//   - Substitute holidays (振替休日) since April 12, 1973.
+//   - Citizens' holidays (国民の休日) since December 27, 1985.
+//   - Holidays by special laws, such as the Imperial ceremonies and the
+//     moved holidays for the Tokyo Olympic Games in 2020 and 2021.
+//
+// Note that the equinox days are officially announced by the government in
+// February of the previous year. They are expected to be the same as the
+// computed ones, but there is no guarantee for the far future.
+type JapaneseHolidays struct{}
+
+var _ HolidayCalendar[tz.AsiaTokyo] = JapaneseHolidays{}
+
+// Names of the holidays which are not national holidays.
+const (
+	jpSubstituteHoliday = "振替休日"
+	jpCitizensHoliday   = "国民の休日"
+)
+
+// IsHoliday reports whether d is a holiday in Japan.
+func (j JapaneseHolidays) IsHoliday(d Date[tz.AsiaTokyo]) bool {
+	_, ok := j.Lookup(d)
+	return ok
+}
+
+// Lookup returns the holiday on d. The boolean is false if d is not a holiday.
+func (JapaneseHolidays) Lookup(d Date[tz.AsiaTokyo]) (Holiday[tz.AsiaTokyo], bool) {
+	for _, h := range jpHolidaysInYear(d.Year()) {
+		if h.Date == d {
+			return h, true
+		}
+	}
+	return Holiday[tz.AsiaTokyo]{}, false
+}
+
+// InYear returns the holidays in the year in chronological order.
+func (JapaneseHolidays) InYear(year int) []Holiday[tz.AsiaTokyo] {
+	holidays := jpHolidaysInYear(year)
+	return append([]Holiday[tz.AsiaTokyo](nil), holidays...)
+}
+
+// jpRule is a rule of a national holiday, which is effective from the year
+// "from" to the year "until" (inclusive). If until is zero, the rule has no end.
+type jpRule struct {
+	name        string
+	from, until int
+	date        func(year int) (time.Month, int)
+}
+
+func jpFixed(month time.Month, day int) func(int) (time.Month, int) {
+	return func(int) (time.Month, int) { return month, day }
+}
+
+// jpMonday returns the n-th Monday of the month.
+func jpMonday(month time.Month, n int) func(int) (time.Month, int) {
+	return func(year int) (time.Month, int) {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		return month, 1 + (int(time.Monday)-int(first)+7)%7 + (n-1)*7
+	}
+}
+
+// jpMoved returns the date by the rule, except for the years moved by special laws.
+func jpMoved(rule func(int) (time.Month, int), moved map[int][2]int) func(int) (time.Month, int) {
+	return func(year int) (time.Month, int) {
+		if d, ok := moved[year]; ok {
+			return time.Month(d[0]), d[1]
+		}
+		return rule(year)
+	}
+}
+
+// The holidays moved by the Act on Special Measures for
+// the Tokyo Olympic and Paralympic Games.
+var (
+	jpMarineDayMoved   = map[int][2]int{2020: {7, 23}, 2021: {7, 22}}
+	jpMountainDayMoved = map[int][2]int{2020: {8, 10}, 2021: {8, 8}}
+	jpSportsDayMoved   = map[int][2]int{2020: {7, 24}, 2021: {7, 23}}
+)
+
+var jpRules = []jpRule{
+	{name: "元日", from: 1949, date: jpFixed(time.January, 1)},
+	{name: "成人の日", from: 1949, until: 1999, date: jpFixed(time.January, 15)},
+	{name: "成人の日", from: 2000, date: jpMonday(time.January, 2)},
+	{name: "建国記念の日", from: 1967, date: jpFixed(time.February, 11)},
+	{name: "天皇誕生日", from: 2020, date: jpFixed(time.February, 23)},
+	{name: "春分の日", from: 1949, date: func(year int) (time.Month, int) {
+		return time.March, jpEquinoxDay(year, time.March)
+	}},
+	{name: "天皇誕生日", from: 1949, until: 1988, date: jpFixed(time.April, 29)},
+	{name: "みどりの日", from: 1989, until: 2006, date: jpFixed(time.April, 29)},
+	{name: "昭和の日", from: 2007, date: jpFixed(time.April, 29)},
+	{name: "憲法記念日", from: 1949, date: jpFixed(time.May, 3)},
+	{name: "みどりの日", from: 2007, date: jpFixed(time.May, 4)},
+	{name: "こどもの日", from: 1949, date: jpFixed(time.May, 5)},
+	{name: "海の日", from: 1996, until: 2002, date: jpFixed(time.July, 20)},
+	{name: "海の日", from: 2003, date: jpMoved(jpMonday(time.July, 3), jpMarineDayMoved)},
+	{name: "山の日", from: 2016, date: jpMoved(jpFixed(time.August, 11), jpMountainDayMoved)},
+	{name: "敬老の日", from: 1966, until: 2002, date: jpFixed(time.September, 15)},
+	{name: "敬老の日", from: 2003, date: jpMonday(time.September, 3)},
+	{name: "秋分の日", from: 1948, date: func(year int) (time.Month, int) {
+		return time.September, jpEquinoxDay(year, time.September)
+	}},
+	{name: "体育の日", from: 1966, until: 1999, date: jpFixed(time.October, 10)},
+	{name: "体育の日", from: 2000, until: 2019, date: jpMonday(time.October, 2)},
+	{name: "スポーツの日", from: 2020, date: jpMoved(jpMonday(time.October, 2), jpSportsDayMoved)},
+	{name: "文化の日", from: 1948, date: jpFixed(time.November, 3)},
+	{name: "勤労感謝の日", from: 1948, date: jpFixed(time.November, 23)},
+	{name: "天皇誕生日", from: 1989, until: 2018, date: jpFixed(time.December, 23)},
+
+	// Holidays by special laws.
+	{name: "皇太子明仁親王の結婚の儀", from: 1959, until: 1959, date: jpFixed(time.April, 10)},
+	{name: "昭和天皇の大喪の礼", from: 1989, until: 1989, date: jpFixed(time.February, 24)},
+	{name: "即位礼正殿の儀", from: 1990, until: 1990, date: jpFixed(time.November, 12)},
+	{name: "皇太子徳仁親王の結婚の儀", from: 1993, until: 1993, date: jpFixed(time.June, 9)},
+	{name: "天皇の即位の日", from: 2019, until: 2019, date: jpFixed(time.May, 1)},
+	{name: "即位礼正殿の儀", from: 2019, until: 2019, date: jpFixed(time.October, 22)},
+}
+
+var (
+	// jpEnforcement is the date on which the Act on National Holidays took effect.
+	jpEnforcement = NewDate[tz.AsiaTokyo](1948, time.July, 20)
+	// jpSubstituteFrom is the date on which substitute holidays were introduced.
+	jpSubstituteFrom = NewDate[tz.AsiaTokyo](1973, time.April, 12)
+	// jpSubstituteRevised is the date on which substitute holidays were revised
+	// to be the nearest day after the holiday which is not a national holiday.
+	jpSubstituteRevised = NewDate[tz.AsiaTokyo](2007, time.January, 1)
+	// jpCitizensFrom is the date on which citizens' holidays were introduced.
+	jpCitizensFrom = NewDate[tz.AsiaTokyo](1985, time.December, 27)
+)
+
+var jpHolidaysCache sync.Map // map[int][]Holiday[tz.AsiaTokyo]
+
+func jpHolidaysInYear(year int) []Holiday[tz.AsiaTokyo] {
+	if v, ok := jpHolidaysCache.Load(year); ok {
+		return v.([]Holiday[tz.AsiaTokyo])
+	}
+	holidays := jpCalculateHolidays(year)
+	jpHolidaysCache.Store(year, holidays)
+	return holidays
+}
+
+func jpCalculateHolidays(year int) []Holiday[tz.AsiaTokyo] {
+	// National holidays, including the ones by special laws.
+	national := make(map[Date[tz.AsiaTokyo]]string)
+	for _, r := range jpRules {
+		if year < r.from || (r.until != 0 && year > r.until) {
+			continue
+		}
+		month, day := r.date(year)
+		d := NewDate[tz.AsiaTokyo](year, month, day)
+		if d.Before(jpEnforcement) {
+			continue
+		}
+		national[d] = r.name
+	}
+
+	holidays := make(map[Date[tz.AsiaTokyo]]string, len(national))
+	for d, name := range national {
+		holidays[d] = name
+	}
+
+	// Substitute holidays (振替休日).
+	for d := range national {
+		if d.Weekday() != time.Sunday || d.Before(jpSubstituteFrom) {
+			continue
+		}
+		next := d.AddDays(1)
+		if d.Before(jpSubstituteRevised) {
+			if _, ok := national[next]; !ok {
+				holidays[next] = jpSubstituteHoliday
+			}
+			continue
+		}
+		for {
+			if _, ok := national[next]; !ok {
+				break
+			}
+			next = next.AddDays(1)
+		}
+		holidays[next] = jpSubstituteHoliday
+	}
+
+	// Citizens' holidays (国民の休日).
+	for d := range national {
+		between := d.AddDays(1)
+		if between.Before(jpCitizensFrom) {
+			continue
+		}
+		if _, ok := national[between.AddDays(1)]; !ok {
+			continue
+		}
+		if _, ok := holidays[between]; ok {
+			continue
+		}
+		// Until 2006, Sundays were not citizens' holidays.
+		if between.Before(jpSubstituteRevised) && between.Weekday() == time.Sunday {
+			continue
+		}
+		holidays[between] = jpCitizensHoliday
+	}
+
+	result := make([]Holiday[tz.AsiaTokyo], 0, len(holidays))
+	for d, name := range holidays {
+		if d.Year() == year {
+			result = append(result, Holiday[tz.AsiaTokyo]{Date: d, Name: name})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+	return result
+}
+
+var jpst = time.FixedZone("JST", 9*60*60)
+
+// jpEquinoxDay returns the day of the month of the vernal (March) or
+// autumnal (September) equinox in Japan Standard Time.
+func jpEquinoxDay(year int, month time.Month) int {
+	return equinox(year, month).In(jpst).Day()
+}
+
+// equinoxTerms are the periodic terms in Table 27.C of Jean Meeus,
+// "Astronomical Algorithms", 2nd edition.
+var equinoxTerms = [24][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467},
+	{199, 342.08, 20.186}, {182, 27.85, 445267.112},
+	{156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906},
+	{70, 243.58, 9037.513}, {58, 119.81, 33718.147},
+	{52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956},
+	{29, 60.93, 4443.417}, {18, 155.12, 67555.328},
+	{17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848},
+	{12, 287.11, 31931.756}, {12, 320.81, 34777.259},
+	{9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// equinox returns the instant of the March or September equinox in the year,
+// using the algorithm in chapter 27 of Jean Meeus, "Astronomical Algorithms".
+// It is valid for the years 1000 to 3000 and accurate to about a minute.
+func equinox(year int, month time.Month) time.Time {
+	y := float64(year-2000) / 1000
+	var jde0 float64
+	if month == time.March {
+		jde0 = 2451623.80984 + 365242.37404*y + 0.05169*y*y - 0.00411*y*y*y - 0.00057*y*y*y*y
+	} else {
+		jde0 = 2451810.21715 + 365242.01767*y - 0.11575*y*y + 0.00337*y*y*y + 0.00078*y*y*y*y
+	}
+	const rad = math.Pi / 180
+	t := (jde0 - 2451545.0) / 36525
+	w := (35999.373*t - 2.47) * rad
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	s := 0.0
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos((term[1]+term[2]*t)*rad)
+	}
+	jde := jde0 + 0.00001*s/dl
+
+	// JDE is in Terrestrial Time. Convert it to UTC.
+	const unixEpochJD = 2440587.5
+	seconds := (jde-unixEpochJD)*86400 - deltaT(year)
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
+
+// deltaT returns the approximate difference between Terrestrial Time and
+// Universal Time in seconds, by the polynomial expressions of Espenak and Meeus.
+func deltaT(year int) float64 {
+	y := float64(year)
+	switch {
+	case year < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
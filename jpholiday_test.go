@@ -0,0 +1,212 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleJapaneseHolidays() {
+	var jp synchro.JapaneseHolidays
+	for _, h := range jp.InYear(2021) {
+		if h.Date.Month() >= time.July && h.Date.Month() <= time.August {
+			fmt.Println(h)
+		}
+	}
+	h, ok := jp.Lookup(synchro.NewDate[tz.AsiaTokyo](2024, 9, 23))
+	fmt.Println(h.Name, ok)
+	// Output:
+	// 2021-07-22 海の日
+	// 2021-07-23 スポーツの日
+	// 2021-08-08 山の日
+	// 2021-08-09 振替休日
+	// 振替休日 true
+}
+
+func TestJapaneseHolidays_InYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want []string
+	}{
+		{
+			year: 2019,
+			want: []string{
+				"2019-01-01 元日",
+				"2019-01-14 成人の日",
+				"2019-02-11 建国記念の日",
+				"2019-03-21 春分の日",
+				"2019-04-29 昭和の日",
+				"2019-04-30 国民の休日",
+				"2019-05-01 天皇の即位の日",
+				"2019-05-02 国民の休日",
+				"2019-05-03 憲法記念日",
+				"2019-05-04 みどりの日",
+				"2019-05-05 こどもの日",
+				"2019-05-06 振替休日",
+				"2019-07-15 海の日",
+				"2019-08-11 山の日",
+				"2019-08-12 振替休日",
+				"2019-09-16 敬老の日",
+				"2019-09-23 秋分の日",
+				"2019-10-14 体育の日",
+				"2019-10-22 即位礼正殿の儀",
+				"2019-11-03 文化の日",
+				"2019-11-04 振替休日",
+				"2019-11-23 勤労感謝の日",
+			},
+		},
+		{
+			year: 2020,
+			want: []string{
+				"2020-01-01 元日",
+				"2020-01-13 成人の日",
+				"2020-02-11 建国記念の日",
+				"2020-02-23 天皇誕生日",
+				"2020-02-24 振替休日",
+				"2020-03-20 春分の日",
+				"2020-04-29 昭和の日",
+				"2020-05-03 憲法記念日",
+				"2020-05-04 みどりの日",
+				"2020-05-05 こどもの日",
+				"2020-05-06 振替休日",
+				"2020-07-23 海の日",
+				"2020-07-24 スポーツの日",
+				"2020-08-10 山の日",
+				"2020-09-21 敬老の日",
+				"2020-09-22 秋分の日",
+				"2020-11-03 文化の日",
+				"2020-11-23 勤労感謝の日",
+			},
+		},
+		{
+			year: 2009,
+			want: []string{
+				"2009-01-01 元日",
+				"2009-01-12 成人の日",
+				"2009-02-11 建国記念の日",
+				"2009-03-20 春分の日",
+				"2009-04-29 昭和の日",
+				"2009-05-03 憲法記念日",
+				"2009-05-04 みどりの日",
+				"2009-05-05 こどもの日",
+				"2009-05-06 振替休日",
+				"2009-07-20 海の日",
+				"2009-09-21 敬老の日",
+				"2009-09-22 国民の休日",
+				"2009-09-23 秋分の日",
+				"2009-10-12 体育の日",
+				"2009-11-03 文化の日",
+				"2009-11-23 勤労感謝の日",
+				"2009-12-23 天皇誕生日",
+			},
+		},
+		{
+			year: 1948,
+			want: []string{
+				"1948-09-23 秋分の日",
+				"1948-11-03 文化の日",
+				"1948-11-23 勤労感謝の日",
+			},
+		},
+		{
+			year: 1947,
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.year), func(t *testing.T) {
+			got := synchro.JapaneseHolidays{}.InYear(tt.year)
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %d holidays but got %d: %v", len(tt.want), len(got), got)
+			}
+			for i := range tt.want {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("[%d] want %q but got %q", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestJapaneseHolidays_Lookup(t *testing.T) {
+	tests := []struct {
+		date synchro.Date[tz.AsiaTokyo]
+		want string
+	}{
+		// Special days.
+		{date: synchro.NewDate[tz.AsiaTokyo](1959, 4, 10), want: "皇太子明仁親王の結婚の儀"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1989, 2, 24), want: "昭和天皇の大喪の礼"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1990, 11, 12), want: "即位礼正殿の儀"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1993, 6, 9), want: "皇太子徳仁親王の結婚の儀"},
+		// Substitute holidays.
+		{date: synchro.NewDate[tz.AsiaTokyo](1973, 2, 12), want: ""}, // before the introduction
+		{date: synchro.NewDate[tz.AsiaTokyo](1973, 4, 30), want: "振替休日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1992, 5, 4), want: "振替休日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](2008, 5, 6), want: "振替休日"},
+		// Citizens' holidays.
+		{date: synchro.NewDate[tz.AsiaTokyo](1985, 5, 4), want: ""}, // before the introduction
+		{date: synchro.NewDate[tz.AsiaTokyo](1988, 5, 4), want: "国民の休日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1997, 5, 4), want: ""}, // Sunday
+		{date: synchro.NewDate[tz.AsiaTokyo](2015, 9, 22), want: "国民の休日"},
+		// Rule changes.
+		{date: synchro.NewDate[tz.AsiaTokyo](1999, 1, 15), want: "成人の日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](2000, 1, 10), want: "成人の日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1988, 4, 29), want: "天皇誕生日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](1989, 4, 29), want: "みどりの日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](2007, 4, 29), want: "昭和の日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](2018, 12, 23), want: "天皇誕生日"},
+		{date: synchro.NewDate[tz.AsiaTokyo](2019, 12, 23), want: ""},
+		{date: synchro.NewDate[tz.AsiaTokyo](2021, 10, 11), want: ""}, // moved by the Olympics
+		{date: synchro.NewDate[tz.AsiaTokyo](2022, 10, 10), want: "スポーツの日"},
+	}
+	var jp synchro.JapaneseHolidays
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			h, ok := jp.Lookup(tt.date)
+			if ok != (tt.want != "") || h.Name != tt.want {
+				t.Errorf("want %q but got %q (%v)", tt.want, h.Name, ok)
+			}
+			if jp.IsHoliday(tt.date) != ok {
+				t.Errorf("IsHoliday must be consistent with Lookup")
+			}
+		})
+	}
+}
+
+func TestJapaneseEquinoxDay(t *testing.T) {
+	// The approximate formula which is widely used in Japan.
+	approximate := func(year int, base float64) int {
+		leap := (year - 1980) / 4
+		if year < 1980 {
+			leap = (year - 1983) / 4
+		}
+		return int(base + 0.242194*float64(year-1980) - float64(leap))
+	}
+	for year := 1949; year <= 2099; year++ {
+		vernal, autumnal := 20.8431, 23.2488
+		if year < 1980 {
+			vernal, autumnal = 20.8357, 23.2588
+		}
+		if want, got := approximate(year, vernal), synchro.JapaneseEquinoxDay(year, time.March); want != got {
+			t.Errorf("vernal equinox day in %d: want %d but got %d", year, want, got)
+		}
+		if want, got := approximate(year, autumnal), synchro.JapaneseEquinoxDay(year, time.September); want != got {
+			t.Errorf("autumnal equinox day in %d: want %d but got %d", year, want, got)
+		}
+	}
+}
+
+func TestJapaneseHolidays_BusinessCalendar(t *testing.T) {
+	c := synchro.NewBusinessCalendar[tz.AsiaTokyo](synchro.WeekendSaturdaySunday, synchro.JapaneseHolidays{})
+	// Golden Week in 2019.
+	got, err := synchro.New[tz.AsiaTokyo](2019, 4, 26, 18, 0, 0, 0).NextBusinessDay(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.New[tz.AsiaTokyo](2019, 5, 7, 18, 0, 0, 0); !want.Equal(got) {
+		t.Errorf("want %v but got %v", want, got)
+	}
+}
@@ -0,0 +1,278 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// LocalDateTime represents a wall-clock date and time which is not bound
+// to any timezone, such as "2024-03-10 02:30". It is similar to
+// NaiveDateTime in Rust chrono.
+//
+// LocalDateTime is useful to hold user input, MySQL DATETIME columns or
+// iCalendar floating times. Use FromLocalDateTime to bind it into Time[T].
+type LocalDateTime struct {
+	// tm holds the wall clock as UTC.
+	tm time.Time
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*LocalDateTime)(nil)
+
+const localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+
+// NewLocalDateTime returns the LocalDateTime corresponding to
+//
+//	yyyy-mm-dd hh:mm:ss + nsec nanoseconds
+//
+// The month, day, hour, min, sec, and nsec values may be outside
+// their usual ranges and will be normalized during the conversion.
+// For example, October 32 converts to November 1.
+func NewLocalDateTime(year int, month time.Month, day int, hour int, min int, sec int, nsec int) LocalDateTime {
+	return LocalDateTime{tm: time.Date(year, month, day, hour, min, sec, nsec, time.UTC)}
+}
+
+// ParseLocalDateTime parses an ISO8601-compliant date or datetime string without
+// a time zone designator and returns the LocalDateTime it represents.
+// Both 'T' and ' ' are accepted as time designators.
+//
+// If the input string has a time zone designator, an error is returned.
+func ParseLocalDateTime(value string) (LocalDateTime, error) {
+	tm, err := iso8601.ParseDateTime(
+		value,
+		iso8601.WithTimeDesignators(' '),
+		iso8601.WithInLocation(time.UTC),
+		iso8601.WithoutTimeZone(),
+	)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{tm: tm}, nil
+}
+
+// LocalDateTime returns the wall-clock reading of t in the timezone T.
+func (t Time[T]) LocalDateTime() LocalDateTime {
+	return NewLocalDateTime(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// Disambiguation specifies how FromLocalDateTime resolves a wall-clock time
+// which is ambiguous or does not exist in a timezone because of a daylight
+// saving time transition.
+type Disambiguation int
+
+const (
+	// DisambiguateCompatible uses the earlier instant for ambiguous times
+	// and moves non-existent times forward by the length of the gap.
+	// This is the same behavior as RFC 5545 (iCalendar).
+	DisambiguateCompatible Disambiguation = iota
+	// DisambiguateEarlier uses the earlier instant for ambiguous times
+	// and moves non-existent times backward by the length of the gap.
+	DisambiguateEarlier
+	// DisambiguateLater uses the later instant for ambiguous times
+	// and moves non-existent times forward by the length of the gap.
+	DisambiguateLater
+	// DisambiguateReject returns an error for ambiguous and non-existent times.
+	DisambiguateReject
+)
+
+var (
+	// ErrAmbiguousTime is returned by FromLocalDateTime when the wall-clock time
+	// occurs twice in the timezone and DisambiguateReject is specified.
+	ErrAmbiguousTime = errors.New("synchro: ambiguous local time")
+	// ErrNonExistentTime is returned by FromLocalDateTime when the wall-clock time
+	// does not exist in the timezone and DisambiguateReject is specified.
+	ErrNonExistentTime = errors.New("synchro: non-existent local time")
+)
+
+// FromLocalDateTime binds the wall-clock time l into the timezone T.
+//
+// A daylight saving time transition skips or repeats wall-clock times.
+// For example, in the United States, March 10, 2024 2:30am never occurred,
+// while November 3, 2024 1:30am occurred twice. The disambiguation d
+// specifies which instant is returned in such cases.
+func FromLocalDateTime[T TimeZone](l LocalDateTime, d Disambiguation) (Time[T], error) {
+	var tz T
+	tm, err := resolveLocalDateTime(l.tm, tz.Location(), d)
+	if err != nil {
+		return Time[T]{}, err
+	}
+	return Time[T]{tm: tm}, nil
+}
+
+func resolveLocalDateTime(wall time.Time, loc *time.Location, d Disambiguation) (time.Time, error) {
+	const secondsPerDay = 24 * 60 * 60
+	sec, nsec := wall.Unix(), int64(wall.Nanosecond())
+	offsetAt := func(unix int64) int64 {
+		_, offset := time.Unix(unix, 0).In(loc).Zone()
+		return int64(offset)
+	}
+
+	// Offsets which could be in effect around the wall-clock time.
+	before, after := offsetAt(sec-secondsPerDay), offsetAt(sec+secondsPerDay)
+	offsets := []int64{before, offsetAt(sec), after}
+
+	var candidates []time.Time
+	seen := make(map[int64]bool, len(offsets))
+	for _, offset := range offsets {
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		if offsetAt(sec-offset) == offset {
+			candidates = append(candidates, time.Unix(sec-offset, nsec).In(loc))
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0: // skipped by the transition
+		switch d {
+		case DisambiguateEarlier:
+			return time.Unix(sec-after, nsec).In(loc), nil
+		case DisambiguateReject:
+			return time.Time{}, fmt.Errorf("%w: %s in %s", ErrNonExistentTime, wall.Format(localDateTimeLayout), loc)
+		default:
+			return time.Unix(sec-before, nsec).In(loc), nil
+		}
+	default: // repeated by the transition
+		switch d {
+		case DisambiguateLater:
+			return candidates[len(candidates)-1], nil
+		case DisambiguateReject:
+			return time.Time{}, fmt.Errorf("%w: %s in %s", ErrAmbiguousTime, wall.Format(localDateTimeLayout), loc)
+		default:
+			return candidates[0], nil
+		}
+	}
+}
+
+// Date returns the year, month, and day of l.
+func (l LocalDateTime) Date() (year int, month time.Month, day int) { return l.tm.Date() }
+
+// Clock returns the hour, minute, and second of l.
+func (l LocalDateTime) Clock() (hour, min, sec int) { return l.tm.Clock() }
+
+// Year returns the year of l.
+func (l LocalDateTime) Year() int { return l.tm.Year() }
+
+// Month returns the month of the year of l.
+func (l LocalDateTime) Month() time.Month { return l.tm.Month() }
+
+// Day returns the day of the month of l.
+func (l LocalDateTime) Day() int { return l.tm.Day() }
+
+// Hour returns the hour of l, in the range [0, 23].
+func (l LocalDateTime) Hour() int { return l.tm.Hour() }
+
+// Minute returns the minute of l, in the range [0, 59].
+func (l LocalDateTime) Minute() int { return l.tm.Minute() }
+
+// Second returns the second of l, in the range [0, 59].
+func (l LocalDateTime) Second() int { return l.tm.Second() }
+
+// Nanosecond returns the nanosecond offset within the second of l,
+// in the range [0, 999999999].
+func (l LocalDateTime) Nanosecond() int { return l.tm.Nanosecond() }
+
+// Weekday returns the day of the week of l.
+func (l LocalDateTime) Weekday() time.Weekday { return l.tm.Weekday() }
+
+// IsZero reports whether l represents the zero value, January 1, year 1, 00:00:00.
+func (l LocalDateTime) IsZero() bool { return l.tm.IsZero() }
+
+// Add returns the wall-clock time l+d.
+func (l LocalDateTime) Add(d time.Duration) LocalDateTime {
+	return LocalDateTime{tm: l.tm.Add(d)}
+}
+
+// AddDate returns the wall-clock time corresponding to adding the
+// given number of years, months, and days to l.
+func (l LocalDateTime) AddDate(years int, months int, days int) LocalDateTime {
+	return LocalDateTime{tm: l.tm.AddDate(years, months, days)}
+}
+
+// Sub returns the difference of the wall-clock readings l-u.
+func (l LocalDateTime) Sub(u LocalDateTime) time.Duration {
+	return l.tm.Sub(u.tm)
+}
+
+// After reports whether l is after u.
+func (l LocalDateTime) After(u LocalDateTime) bool { return l.tm.After(u.tm) }
+
+// Before reports whether l is before u.
+func (l LocalDateTime) Before(u LocalDateTime) bool { return l.tm.Before(u.tm) }
+
+// Equal reports whether l and u represent the same wall-clock time.
+func (l LocalDateTime) Equal(u LocalDateTime) bool { return l.tm.Equal(u.tm) }
+
+// Compare compares l with u. If l is before u, it returns -1;
+// if l is after u, it returns +1; if they're the same, it returns 0.
+func (l LocalDateTime) Compare(u LocalDateTime) int { return l.tm.Compare(u.tm) }
+
+// Format returns a textual representation of l formatted according
+// to the layout defined by the argument. See time.Layout.
+//
+// Since l has no timezone, zone related elements of the layout are
+// formatted as UTC.
+func (l LocalDateTime) Format(layout string) string {
+	return l.tm.Format(layout)
+}
+
+// String returns the ISO8601 string representation of the format
+// "YYYY-MM-DDThh:mm:ss" with sub-second precision if present.
+// For example: "2024-03-10T02:30:00".
+func (l LocalDateTime) String() string {
+	return l.tm.Format(localDateTimeLayout)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the same as String.
+func (l LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value must be in a format supported by ParseLocalDateTime.
+func (l *LocalDateTime) UnmarshalText(data []byte) error {
+	parsed, err := ParseLocalDateTime(string(data))
+	if err != nil {
+		return err
+	}
+	*l = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is a quoted string which is the same as String.
+func (l LocalDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be a quoted string in a format supported by ParseLocalDateTime.
+func (l *LocalDateTime) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(s))
+}
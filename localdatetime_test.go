@@ -0,0 +1,229 @@
+package synchro_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleFromLocalDateTime() {
+	// 2:30am on March 10, 2024 never occurred in New York.
+	l, _ := synchro.ParseLocalDateTime("2024-03-10 02:30")
+
+	t, _ := synchro.FromLocalDateTime[tz.AmericaNew_York](l, synchro.DisambiguateCompatible)
+	fmt.Println(t)
+
+	t, _ = synchro.FromLocalDateTime[tz.AmericaNew_York](l, synchro.DisambiguateEarlier)
+	fmt.Println(t)
+
+	_, err := synchro.FromLocalDateTime[tz.AmericaNew_York](l, synchro.DisambiguateReject)
+	fmt.Println(err)
+	// Output:
+	// 2024-03-10 03:30:00 -0400 EDT
+	// 2024-03-10 01:30:00 -0500 EST
+	// synchro: non-existent local time: 2024-03-10T02:30:00 in America/New_York
+}
+
+func ExampleTime_LocalDateTime() {
+	t := synchro.New[tz.AsiaTokyo](2023, 9, 2, 23, 0, 0, 0)
+	fmt.Println(t.LocalDateTime())
+	// Output:
+	// 2023-09-02T23:00:00
+}
+
+func TestParseLocalDateTime(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.LocalDateTime
+		wantErr bool
+	}{
+		{
+			value: "2024-03-10T02:30:00",
+			want:  synchro.NewLocalDateTime(2024, 3, 10, 2, 30, 0, 0),
+		},
+		{
+			value: "2024-03-10 02:30:00.123",
+			want:  synchro.NewLocalDateTime(2024, 3, 10, 2, 30, 0, 123000000),
+		},
+		{
+			value: "2024-03-10",
+			want:  synchro.NewLocalDateTime(2024, 3, 10, 0, 0, 0, 0),
+		},
+		{
+			value:   "2024-03-10T02:30:00Z",
+			wantErr: true,
+		},
+		{
+			value:   "2024-03-10T02:30:00+09:00",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseLocalDateTime(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want.Equal(got) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFromLocalDateTime(t *testing.T) {
+	gap := synchro.NewLocalDateTime(2024, 3, 10, 2, 30, 0, 0)
+	overlap := synchro.NewLocalDateTime(2024, 11, 3, 1, 30, 0, 0)
+	tests := []struct {
+		name    string
+		local   synchro.LocalDateTime
+		d       synchro.Disambiguation
+		want    time.Time
+		wantErr error
+	}{
+		{
+			name:  "normal",
+			local: synchro.NewLocalDateTime(2024, 7, 1, 12, 0, 0, 0),
+			d:     synchro.DisambiguateReject,
+			want:  time.Date(2024, 7, 1, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "gap compatible",
+			local: gap,
+			d:     synchro.DisambiguateCompatible,
+			want:  time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "gap earlier",
+			local: gap,
+			d:     synchro.DisambiguateEarlier,
+			want:  time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "gap later",
+			local: gap,
+			d:     synchro.DisambiguateLater,
+			want:  time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "gap reject",
+			local:   gap,
+			d:       synchro.DisambiguateReject,
+			wantErr: synchro.ErrNonExistentTime,
+		},
+		{
+			name:  "overlap compatible",
+			local: overlap,
+			d:     synchro.DisambiguateCompatible,
+			want:  time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "overlap earlier",
+			local: overlap,
+			d:     synchro.DisambiguateEarlier,
+			want:  time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "overlap later",
+			local: overlap,
+			d:     synchro.DisambiguateLater,
+			want:  time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "overlap reject",
+			local:   overlap,
+			d:       synchro.DisambiguateReject,
+			wantErr: synchro.ErrAmbiguousTime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := synchro.FromLocalDateTime[tz.AmericaNew_York](tt.local, tt.d)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want error %v but got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.StdTime().Equal(tt.want) {
+				t.Errorf("want %s but got %s", tt.want, got.StdTime().UTC())
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_RoundTrip(t *testing.T) {
+	tm := synchro.New[tz.AmericaNew_York](2024, 11, 3, 1, 30, 0, 0).Add(time.Hour) // 1:30 EST
+	l := tm.LocalDateTime()
+	if want := synchro.NewLocalDateTime(2024, 11, 3, 1, 30, 0, 0); !want.Equal(l) {
+		t.Fatalf("want %s but got %s", want, l)
+	}
+	got, err := synchro.FromLocalDateTime[tz.AmericaNew_York](l, synchro.DisambiguateLater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(tm) {
+		t.Errorf("want %s but got %s", tm, got)
+	}
+}
+
+func TestLocalDateTime_Compare(t *testing.T) {
+	l1 := synchro.NewLocalDateTime(2024, 1, 1, 0, 0, 0, 0)
+	l2 := l1.Add(time.Minute)
+	if !l1.Before(l2) || !l2.After(l1) {
+		t.Errorf("%s should be before %s", l1, l2)
+	}
+	if got := l2.Compare(l1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := l2.Sub(l1); got != time.Minute {
+		t.Errorf("want %v but got %v", time.Minute, got)
+	}
+	if got := l1.AddDate(0, 1, 0); !got.Equal(synchro.NewLocalDateTime(2024, 2, 1, 0, 0, 0, 0)) {
+		t.Errorf("want 2024-02-01 but got %s", got)
+	}
+	if got, want := l1.Format("Jan 2, 2006 15:04"), "Jan 1, 2024 00:00"; got != want {
+		t.Errorf("want %q but got %q", want, got)
+	}
+}
+
+func TestLocalDateTime_Scan(t *testing.T) {
+	want := synchro.NewLocalDateTime(2023, 9, 10, 14, 3, 54, 0)
+	for _, src := range []any{
+		"2023-09-10 14:03:54",
+		[]byte("2023-09-10T14:03:54"),
+		time.Date(2023, 9, 10, 14, 3, 54, 0, time.FixedZone("", 3600)),
+	} {
+		var got synchro.LocalDateTime
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("Scan(%v) returned an unexpected error: %v", src, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("Scan(%v) = %v, want %v", src, got, want)
+		}
+	}
+	var got synchro.LocalDateTime
+	if err := got.Scan(123); err == nil {
+		t.Errorf("expected error")
+	}
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "2023-09-10 14:03:54" {
+		t.Errorf("unexpected value %q", v)
+	}
+}
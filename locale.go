@@ -0,0 +1,408 @@
+package synchro
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale represents the locale-specific data which is used to describe times
+// in natural languages.
+//
+// The built-in locales are EnglishLocale, JapaneseLocale, GermanLocale and
+// FrenchLocale. Use RegisterLocale to add your own locale.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale. For example: "en", "ja".
+	Tag string
+
+	// MonthNames are the names of months from January.
+	MonthNames [12]string
+	// ShortMonthNames are the abbreviated names of months from January.
+	ShortMonthNames [12]string
+	// WeekdayNames are the names of days of the week from Sunday.
+	WeekdayNames [7]string
+	// ShortWeekdayNames are the abbreviated names of days of the week from Sunday.
+	ShortWeekdayNames [7]string
+	// AM and PM are the markers of the time before and after noon.
+	AM, PM string
+
+	// DateLayout is the layout of numeric dates, which determines the order
+	// of year, month and day in the locale. For example: "01/02/2006".
+	DateLayout string
+	// LongDateLayout is the layout of dates with the month name.
+	// For example: "January 2, 2006".
+	LongDateLayout string
+
+	// RelativeTime is the data to describe relative times by Humanize.
+	RelativeTime RelativeTimeNames
+}
+
+// RelativeUnit represents a unit of relative times.
+type RelativeUnit int
+
+const (
+	RelativeSecond RelativeUnit = iota
+	RelativeMinute
+	RelativeHour
+	RelativeDay
+	RelativeWeek
+	RelativeMonth
+	RelativeYear
+)
+
+// PluralNames are the names of a unit for each plural form. Both names are
+// the format strings which take the number of the unit as "%d".
+type PluralNames struct {
+	// One is used when the number is 1.
+	One string
+	// Other is used otherwise.
+	Other string
+}
+
+func (p PluralNames) name(n int) string {
+	if n == 1 && p.One != "" {
+		return p.One
+	}
+	return p.Other
+}
+
+// RelativeTimeNames are the names to describe relative times.
+// Empty names and missing units fall back to those of EnglishLocale.
+type RelativeTimeNames struct {
+	// JustNow describes the time close to the reference time.
+	JustNow string
+	// Past is the format string of the past time which takes the duration as "%s".
+	Past string
+	// Future is the format string of the future time which takes the duration as "%s".
+	Future string
+	// Units are the names of the units.
+	Units map[RelativeUnit]PluralNames
+}
+
+// withFallback returns n whose empty names are filled with those of fallback.
+func (n RelativeTimeNames) withFallback(fallback RelativeTimeNames) RelativeTimeNames {
+	if n.JustNow == "" {
+		n.JustNow = fallback.JustNow
+	}
+	if n.Past == "" {
+		n.Past = fallback.Past
+	}
+	if n.Future == "" {
+		n.Future = fallback.Future
+	}
+	return n
+}
+
+// unit returns the names of the unit u, or those of fallback if u is missing.
+func (n RelativeTimeNames) unit(u RelativeUnit, fallback RelativeTimeNames) PluralNames {
+	if p, ok := n.Units[u]; ok && p.Other != "" {
+		return p
+	}
+	return fallback.Units[u]
+}
+
+// EnglishLocale is the locale of English.
+var EnglishLocale = &Locale{
+	Tag: "en",
+	MonthNames: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	ShortMonthNames: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	WeekdayNames: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	ShortWeekdayNames: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	AM:                "AM",
+	PM:                "PM",
+	DateLayout:        "01/02/2006",
+	LongDateLayout:    "January 2, 2006",
+	RelativeTime: RelativeTimeNames{
+		JustNow: "just now",
+		Past:    "%s ago",
+		Future:  "in %s",
+		Units: map[RelativeUnit]PluralNames{
+			RelativeSecond: {One: "%d second", Other: "%d seconds"},
+			RelativeMinute: {One: "%d minute", Other: "%d minutes"},
+			RelativeHour:   {One: "%d hour", Other: "%d hours"},
+			RelativeDay:    {One: "%d day", Other: "%d days"},
+			RelativeWeek:   {One: "%d week", Other: "%d weeks"},
+			RelativeMonth:  {One: "%d month", Other: "%d months"},
+			RelativeYear:   {One: "%d year", Other: "%d years"},
+		},
+	},
+}
+
+// JapaneseLocale is the locale of Japanese.
+var JapaneseLocale = &Locale{
+	Tag: "ja",
+	MonthNames: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	ShortMonthNames: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	WeekdayNames: [7]string{
+		"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+	},
+	ShortWeekdayNames: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	AM:                "午前",
+	PM:                "午後",
+	DateLayout:        "2006/01/02",
+	LongDateLayout:    "2006年1月2日",
+	RelativeTime: RelativeTimeNames{
+		JustNow: "たった今",
+		Past:    "%s前",
+		Future:  "%s後",
+		Units: map[RelativeUnit]PluralNames{
+			RelativeSecond: {Other: "%d秒"},
+			RelativeMinute: {Other: "%d分"},
+			RelativeHour:   {Other: "%d時間"},
+			RelativeDay:    {Other: "%d日"},
+			RelativeWeek:   {Other: "%d週間"},
+			RelativeMonth:  {Other: "%dか月"},
+			RelativeYear:   {Other: "%d年"},
+		},
+	},
+}
+
+// GermanLocale is the locale of German.
+var GermanLocale = &Locale{
+	Tag: "de",
+	MonthNames: [12]string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	ShortMonthNames: [12]string{
+		"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+		"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+	},
+	WeekdayNames: [7]string{
+		"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+	},
+	ShortWeekdayNames: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	AM:                "AM",
+	PM:                "PM",
+	DateLayout:        "02.01.2006",
+	LongDateLayout:    "2. January 2006",
+	RelativeTime: RelativeTimeNames{
+		JustNow: "gerade eben",
+		Past:    "vor %s",
+		Future:  "in %s",
+		Units: map[RelativeUnit]PluralNames{
+			RelativeSecond: {One: "%d Sekunde", Other: "%d Sekunden"},
+			RelativeMinute: {One: "%d Minute", Other: "%d Minuten"},
+			RelativeHour:   {One: "%d Stunde", Other: "%d Stunden"},
+			RelativeDay:    {One: "%d Tag", Other: "%d Tagen"},
+			RelativeWeek:   {One: "%d Woche", Other: "%d Wochen"},
+			RelativeMonth:  {One: "%d Monat", Other: "%d Monaten"},
+			RelativeYear:   {One: "%d Jahr", Other: "%d Jahren"},
+		},
+	},
+}
+
+// FrenchLocale is the locale of French.
+var FrenchLocale = &Locale{
+	Tag: "fr",
+	MonthNames: [12]string{
+		"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	ShortMonthNames: [12]string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	WeekdayNames: [7]string{
+		"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+	},
+	ShortWeekdayNames: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	AM:                "AM",
+	PM:                "PM",
+	DateLayout:        "02/01/2006",
+	LongDateLayout:    "2 January 2006",
+	RelativeTime: RelativeTimeNames{
+		JustNow: "à l’instant",
+		Past:    "il y a %s",
+		Future:  "dans %s",
+		Units: map[RelativeUnit]PluralNames{
+			RelativeSecond: {One: "%d seconde", Other: "%d secondes"},
+			RelativeMinute: {One: "%d minute", Other: "%d minutes"},
+			RelativeHour:   {One: "%d heure", Other: "%d heures"},
+			RelativeDay:    {One: "%d jour", Other: "%d jours"},
+			RelativeWeek:   {One: "%d semaine", Other: "%d semaines"},
+			RelativeMonth:  {Other: "%d mois"},
+			RelativeYear:   {One: "%d an", Other: "%d ans"},
+		},
+	},
+}
+
+// builtinLocales are the locales which are registered by default.
+var builtinLocales = map[string]*Locale{
+	"en": EnglishLocale,
+	"ja": JapaneseLocale,
+	"de": GermanLocale,
+	"fr": FrenchLocale,
+}
+
+var locales = struct {
+	sync.RWMutex
+	m map[string]*Locale
+}{
+	m: func() map[string]*Locale {
+		m := make(map[string]*Locale, len(builtinLocales))
+		for tag, l := range builtinLocales {
+			m[tag] = l
+		}
+		return m
+	}(),
+}
+
+func canonicalLocaleTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+// RegisterLocale registers the locale by its tag. If a locale with the same
+// tag is already registered, it is replaced. Tags are case-insensitive.
+//
+// It panics if the locale is nil or its tag is empty.
+func RegisterLocale(l *Locale) {
+	if l == nil || l.Tag == "" {
+		panic("synchro: locale must have a tag")
+	}
+	locales.Lock()
+	defer locales.Unlock()
+	locales.m[canonicalLocaleTag(l.Tag)] = l
+}
+
+// UnregisterLocale removes the locale registered by the tag, such as the
+// locale registered temporarily in tests. Tags are case-insensitive.
+// It does nothing if no locale is registered by the tag.
+//
+// The built-in locales "en", "ja", "de" and "fr" are never removed. If one of
+// them has been replaced by RegisterLocale, the built-in locale is restored.
+func UnregisterLocale(tag string) {
+	tag = canonicalLocaleTag(tag)
+	locales.Lock()
+	defer locales.Unlock()
+	if l, ok := builtinLocales[tag]; ok {
+		locales.m[tag] = l
+		return
+	}
+	delete(locales.m, tag)
+}
+
+// LookupLocale returns the locale registered by the tag. If the tag is not
+// registered but its language is, such as "en" for "en-US", the locale of
+// the language is returned. The boolean is false if no locale is found.
+func LookupLocale(tag string) (*Locale, bool) {
+	tag = canonicalLocaleTag(tag)
+	locales.RLock()
+	defer locales.RUnlock()
+	for {
+		if l, ok := locales.m[tag]; ok {
+			return l, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return nil, false
+		}
+		tag = tag[:i]
+	}
+}
+
+// FormatLocale is like Format but the names of months and days of the week,
+// and the AM/PM markers in the layout are written in the locale l.
+//
+// The names which are not defined in l are written in English as Format does.
+// For example:
+//
+//	t.FormatLocale(synchro.JapaneseLocale, "2006年1月2日 (Mon) PM3:04")
+//	// => "2024年1月15日 (月) 午後3:04"
+func (t Time[T]) FormatLocale(l *Locale, layout string) string {
+	var b strings.Builder
+	for layout != "" {
+		prefix, name, suffix := nextLocaleChunk(layout)
+		if prefix != "" {
+			b.WriteString(t.tm.Format(prefix))
+		}
+		if name != "" {
+			if s := l.name(t.tm, name); s != "" {
+				b.WriteString(s)
+			} else {
+				b.WriteString(t.tm.Format(name))
+			}
+		}
+		layout = suffix
+	}
+	return b.String()
+}
+
+// name returns the name in l for the locale-dependent layout element.
+func (l *Locale) name(tm time.Time, element string) string {
+	switch element {
+	case "January":
+		return l.MonthNames[tm.Month()-1]
+	case "Jan":
+		return l.ShortMonthNames[tm.Month()-1]
+	case "Monday":
+		return l.WeekdayNames[tm.Weekday()]
+	case "Mon":
+		return l.ShortWeekdayNames[tm.Weekday()]
+	case "PM", "pm":
+		marker := l.AM
+		if tm.Hour() >= 12 {
+			marker = l.PM
+		}
+		if element == "pm" {
+			return strings.ToLower(marker)
+		}
+		return marker
+	}
+	return ""
+}
+
+// nextLocaleChunk splits the layout at the first locale-dependent element
+// in the same way as the time package recognizes it.
+func nextLocaleChunk(layout string) (prefix, element, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		switch c := layout[i]; c {
+		case 'J': // January, Jan
+			if strings.HasPrefix(layout[i:], "Jan") {
+				if strings.HasPrefix(layout[i:], "January") {
+					return layout[:i], "January", layout[i+7:]
+				}
+				if !startsWithLowerCase(layout[i+3:]) {
+					return layout[:i], "Jan", layout[i+3:]
+				}
+			}
+		case 'M': // Monday, Mon
+			if strings.HasPrefix(layout[i:], "Mon") {
+				if strings.HasPrefix(layout[i:], "Monday") {
+					return layout[:i], "Monday", layout[i+6:]
+				}
+				if !startsWithLowerCase(layout[i+3:]) {
+					return layout[:i], "Mon", layout[i+3:]
+				}
+			}
+		case 'P', 'p': // PM, pm
+			if i+1 < len(layout) && layout[i+1] == c+'M'-'P' {
+				return layout[:i], layout[i : i+2], layout[i+2:]
+			}
+		}
+	}
+	return layout, "", ""
+}
+
+// startsWithLowerCase reports whether the string has a lower-case letter at the beginning.
+func startsWithLowerCase(str string) bool {
+	if len(str) == 0 {
+		return false
+	}
+	c := str[0]
+	return 'a' <= c && c <= 'z'
+}
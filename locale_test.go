@@ -0,0 +1,154 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_FormatLocale() {
+	t := synchro.New[tz.AsiaTokyo](2024, 1, 15, 15, 4, 5, 0)
+	fmt.Println(t.FormatLocale(synchro.JapaneseLocale, "2006年1月2日 (Mon) PM3:04"))
+	fmt.Println(t.FormatLocale(synchro.GermanLocale, "Monday, 2. January 2006"))
+	fmt.Println(t.FormatLocale(synchro.FrenchLocale, synchro.FrenchLocale.LongDateLayout))
+	// Output:
+	// 2024年1月15日 (月) 午後3:04
+	// Montag, 15. Januar 2024
+	// 15 janvier 2024
+}
+
+func ExampleRegisterLocale() {
+	synchro.RegisterLocale(&synchro.Locale{
+		Tag: "es",
+		MonthNames: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		LongDateLayout: "2 de January de 2006",
+	})
+	defer synchro.UnregisterLocale("es")
+
+	l, _ := synchro.LookupLocale("es-MX")
+	t := synchro.New[tz.UTC](2024, 3, 1, 0, 0, 0, 0)
+	fmt.Println(t.FormatLocale(l, l.LongDateLayout))
+	// Output: 1 de marzo de 2024
+}
+
+func TestTime_FormatLocale(t *testing.T) {
+	am := synchro.New[tz.UTC](2024, 9, 1, 9, 30, 0, 0) // Sunday
+	pm := synchro.New[tz.UTC](2024, 12, 31, 23, 0, 0, 0)
+	tests := []struct {
+		name   string
+		t      synchro.Time[tz.UTC]
+		locale *synchro.Locale
+		layout string
+		want   string
+	}{
+		{name: "en long", t: am, locale: synchro.EnglishLocale, layout: "Monday, January 2, 2006 3:04 PM", want: "Sunday, September 1, 2024 9:30 AM"},
+		{name: "en short", t: pm, locale: synchro.EnglishLocale, layout: "Mon Jan 2 15:04 pm", want: "Tue Dec 31 23:00 pm"},
+		{name: "ja", t: am, locale: synchro.JapaneseLocale, layout: "Jan2日(Mon) PM3時", want: "9月1日(日) 午前9時"},
+		{name: "ja weekday", t: pm, locale: synchro.JapaneseLocale, layout: "Monday", want: "火曜日"},
+		{name: "de", t: pm, locale: synchro.GermanLocale, layout: "Mon, 2. Jan 2006", want: "Di., 31. Dez. 2024"},
+		{name: "fr", t: am, locale: synchro.FrenchLocale, layout: "Monday 2 January 2006", want: "dimanche 1 septembre 2024"},
+		{name: "fr short", t: pm, locale: synchro.FrenchLocale, layout: "Mon 2 Jan", want: "mar. 31 déc."},
+		{name: "date layout", t: pm, locale: synchro.GermanLocale, layout: synchro.GermanLocale.DateLayout, want: "31.12.2024"},
+		{name: "not names", t: am, locale: synchro.JapaneseLocale, layout: "Month Janet MST", want: "Month Janet UTC"},
+		{name: "undefined names", t: am, locale: &synchro.Locale{Tag: "xx"}, layout: "Monday January PM", want: "Sunday September AM"},
+		{name: "no names", t: am, locale: synchro.JapaneseLocale, layout: time.RFC3339, want: "2024-09-01T09:30:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.FormatLocale(tt.locale, tt.layout); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want *synchro.Locale
+	}{
+		{tag: "en", want: synchro.EnglishLocale},
+		{tag: "en-US", want: synchro.EnglishLocale},
+		{tag: "ja_JP", want: synchro.JapaneseLocale},
+		{tag: "DE", want: synchro.GermanLocale},
+		{tag: "fr-CA", want: synchro.FrenchLocale},
+		{tag: "xx", want: nil},
+		{tag: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := synchro.LookupLocale(tt.tag)
+			if got != tt.want || ok != (tt.want != nil) {
+				t.Errorf("want %v but got %v (%v)", tt.want, got, ok)
+			}
+		})
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	l := &synchro.Locale{Tag: "en-GB", DateLayout: "02/01/2006"}
+	synchro.RegisterLocale(l)
+	t.Cleanup(func() { synchro.UnregisterLocale("en-GB") })
+	if got, _ := synchro.LookupLocale("en-gb"); got != l {
+		t.Errorf("want the registered locale but got %v", got)
+	}
+	if got, _ := synchro.LookupLocale("en-US"); got != synchro.EnglishLocale {
+		t.Errorf("want EnglishLocale but got %v", got)
+	}
+
+	synchro.UnregisterLocale("EN_gb")
+	if got, _ := synchro.LookupLocale("en-GB"); got != synchro.EnglishLocale {
+		t.Errorf("want EnglishLocale after unregistering but got %v", got)
+	}
+
+	// The built-in locales are restored instead of being removed.
+	ja := &synchro.Locale{Tag: "ja"}
+	synchro.RegisterLocale(ja)
+	if got, _ := synchro.LookupLocale("ja"); got != ja {
+		t.Errorf("want the replaced locale but got %v", got)
+	}
+	for _, tag := range []string{"ja", "EN"} {
+		synchro.UnregisterLocale(tag)
+	}
+	if got, _ := synchro.LookupLocale("ja-JP"); got != synchro.JapaneseLocale {
+		t.Errorf("want JapaneseLocale after unregistering but got %v", got)
+	}
+	if got, _ := synchro.LookupLocale("en"); got != synchro.EnglishLocale {
+		t.Errorf("want EnglishLocale after unregistering but got %v", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("want panic for the locale without tag")
+		}
+	}()
+	synchro.RegisterLocale(&synchro.Locale{})
+}
+
+func TestTime_HumanizeLocales(t *testing.T) {
+	ref := synchro.New[tz.UTC](2024, 1, 15, 12, 0, 0, 0)
+	tests := []struct {
+		locale *synchro.Locale
+		d      time.Duration
+		want   string
+	}{
+		{locale: synchro.GermanLocale, d: -2 * 24 * time.Hour, want: "vor 2 Tagen"},
+		{locale: synchro.GermanLocale, d: time.Hour, want: "in 1 Stunde"},
+		{locale: synchro.FrenchLocale, d: -3 * time.Minute, want: "il y a 3 minutes"},
+		{locale: synchro.FrenchLocale, d: 100 * 24 * time.Hour, want: "dans 3 mois"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := ref.Add(tt.d).Humanize(ref, synchro.WithLocale(tt.locale))
+			if got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
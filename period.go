@@ -0,0 +1,161 @@
+package synchro
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// AddISODuration returns the time corresponding to adding the ISO 8601
+// duration d to t.
+//
+// Unlike d.StdDuration(), which approximates a year as 365.2425 days and
+// a month as 30.44 days, the date components of d are applied as calendar
+// steps in the timezone T and the time components are applied as elapsed
+// time. The components are applied in the following order, which is the
+// same as the algorithm in XML Schema Part 2 Appendix E:
+//
+//  1. Years and months are added. If the day of month does not exist in the
+//     resulting month, it is clamped to the last day of that month.
+//     For example, January 31 + P1M is February 28 (or 29 in leap years).
+//  2. Weeks and days are added, keeping the wall-clock time in T.
+//     For example, P1D across a daylight saving time transition is 23 or 25 hours.
+//  3. Hours, minutes, seconds and sub-seconds are added as elapsed time.
+//
+// If d is negative, each component is subtracted in the same order.
+//
+// If the wall-clock time does not exist or is ambiguous after step 2, it is
+// resolved with DisambiguateCompatible.
+func (t Time[T]) AddISODuration(d iso8601.Duration) Time[T] {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+	months := sign * (d.Year*12 + int(d.Month))
+	days := sign * (d.Week*7 + d.Day)
+	if months != 0 || days != 0 {
+		year, month, day := addMonthsClamped(t.Year(), t.Month(), t.Day(), months)
+		l := NewLocalDateTime(year, month, day+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+		t, _ = FromLocalDateTime[T](l, DisambiguateCompatible) // never fails
+	}
+	elapsed := time.Duration(d.Hour)*time.Hour +
+		time.Duration(d.Minute)*time.Minute +
+		time.Duration(d.Second)*time.Second +
+		time.Duration(d.Millisecond)*time.Millisecond +
+		time.Duration(d.Microsecond)*time.Microsecond +
+		time.Duration(d.Nanosecond)
+	return t.Add(time.Duration(sign) * elapsed)
+}
+
+// addMonthsClamped adds months to the given date. If the day of month does
+// not exist in the resulting month, it is clamped to the last day of that month.
+func addMonthsClamped(year int, month time.Month, day int, months int) (int, time.Month, int) {
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	if last := daysIn(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return first.Year(), first.Month(), day
+}
+
+// daysIn returns the number of days in the month of the year.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// scaleISODuration returns the duration d multiplied by k component-wise.
+func scaleISODuration(d iso8601.Duration, k int) iso8601.Duration {
+	if k < 0 {
+		d, k = d.Negate(), -k
+	}
+	d.Year *= k
+	d.Month *= time.Month(k)
+	d.Week *= k
+	d.Day *= k
+	d.Hour *= k
+	d.Minute *= k
+	d.Second *= k
+	d.Millisecond *= k
+	d.Microsecond *= k
+	d.Nanosecond *= k
+	return d
+}
+
+// elapsedISODuration returns the ISO 8601 duration which consists of only
+// the time components equivalent to d.
+func elapsedISODuration(d time.Duration) iso8601.Duration {
+	negative := d < 0
+	if negative {
+		d = -d
+	}
+	return iso8601.Duration{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+		Negative:   negative,
+	}
+}
+
+// parseYearPeriod parses a string which consists of a 4-digit year, an optional
+// '-', the designator and the number of the period with the given digits, such
+// as "2024-W07" or "2024-Q3". The number is not validated.
+func parseYearPeriod(value string, designator byte, digits int, expected string) (year, number int, _ error) {
+	s := value
+	if len(s) < 4 || !isDigits(s[:4]) {
+		return 0, 0, &iso8601.UnexpectedTokenError{
+			Value:    value,
+			Token:    s,
+			Expected: "4-digit year",
+			Length:   len(s),
+			Kind:     unexpectedKind(s),
+		}
+	}
+	year, _ = strconv.Atoi(s[:4])
+	s = s[4:]
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+	}
+	if len(s) == 0 || s[0] != designator {
+		return 0, 0, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			Token:      s,
+			AfterToken: value[:len(value)-len(s)],
+			Expected:   string(designator),
+			Offset:     len(value) - len(s),
+			Length:     len(s),
+			Kind:       unexpectedKind(s),
+		}
+	}
+	s = s[1:]
+	if len(s) != digits || !isDigits(s) {
+		return 0, 0, &iso8601.UnexpectedTokenError{
+			Value:      value,
+			Token:      s,
+			AfterToken: value[:len(value)-len(s)],
+			Expected:   expected,
+			Offset:     len(value) - len(s),
+			Length:     len(s),
+			Kind:       unexpectedKind(s),
+		}
+	}
+	number, _ = strconv.Atoi(s)
+	return year, number, nil
+}
+
+// unexpectedKind returns the kind of the error for the unexpected token.
+func unexpectedKind(token string) iso8601.ErrorKind {
+	if token == "" {
+		return iso8601.KindUnexpectedEnd
+	}
+	return iso8601.KindUnexpectedToken
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
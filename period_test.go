@@ -0,0 +1,95 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_AddISODuration() {
+	t := synchro.New[tz.UTC](2024, 1, 31, 10, 0, 0, 0)
+	d, _ := iso8601.ParseDuration("P1M")
+	fmt.Println(t.AddISODuration(d))
+	fmt.Println(t.Add(d.StdDuration()))
+	// Output:
+	// 2024-02-29 10:00:00 +0000 UTC
+	// 2024-03-01 20:33:36 +0000 UTC
+}
+
+func TestTime_AddISODuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        synchro.Time[tz.AmericaNew_York]
+		duration string
+		want     synchro.Time[tz.AmericaNew_York]
+	}{
+		{
+			name:     "month end is clamped",
+			t:        synchro.New[tz.AmericaNew_York](2023, 1, 31, 9, 0, 0, 0),
+			duration: "P1M",
+			want:     synchro.New[tz.AmericaNew_York](2023, 2, 28, 9, 0, 0, 0),
+		},
+		{
+			name:     "leap day is clamped",
+			t:        synchro.New[tz.AmericaNew_York](2024, 2, 29, 9, 0, 0, 0),
+			duration: "P1Y",
+			want:     synchro.New[tz.AmericaNew_York](2025, 2, 28, 9, 0, 0, 0),
+		},
+		{
+			name:     "months are clamped before adding days",
+			t:        synchro.New[tz.AmericaNew_York](2023, 1, 31, 9, 0, 0, 0),
+			duration: "P1M1D",
+			want:     synchro.New[tz.AmericaNew_York](2023, 3, 1, 9, 0, 0, 0),
+		},
+		{
+			name:     "a day over the DST transition keeps the wall clock",
+			t:        synchro.New[tz.AmericaNew_York](2024, 3, 9, 12, 0, 0, 0),
+			duration: "P1D",
+			want:     synchro.New[tz.AmericaNew_York](2024, 3, 10, 12, 0, 0, 0),
+		},
+		{
+			name:     "24 hours over the DST transition is elapsed time",
+			t:        synchro.New[tz.AmericaNew_York](2024, 3, 9, 12, 0, 0, 0),
+			duration: "PT24H",
+			want:     synchro.New[tz.AmericaNew_York](2024, 3, 10, 13, 0, 0, 0),
+		},
+		{
+			name:     "weeks",
+			t:        synchro.New[tz.AmericaNew_York](2024, 3, 1, 12, 0, 0, 0),
+			duration: "P2W",
+			want:     synchro.New[tz.AmericaNew_York](2024, 3, 15, 12, 0, 0, 0),
+		},
+		{
+			name:     "all components",
+			t:        synchro.New[tz.AmericaNew_York](2023, 1, 1, 0, 0, 0, 0),
+			duration: "P1Y2M3DT4H5M6.007S",
+			want:     synchro.New[tz.AmericaNew_York](2024, 3, 4, 4, 5, 6, 7000000),
+		},
+		{
+			name:     "negative",
+			t:        synchro.New[tz.AmericaNew_York](2024, 3, 31, 12, 0, 0, 0),
+			duration: "-P1MT1H",
+			want:     synchro.New[tz.AmericaNew_York](2024, 2, 29, 11, 0, 0, 0),
+		},
+		{
+			name:     "non-existent wall clock moves forward",
+			t:        synchro.New[tz.AmericaNew_York](2024, 3, 9, 2, 30, 0, 0),
+			duration: "P1D",
+			want:     synchro.New[tz.AmericaNew_York](2024, 3, 10, 3, 30, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := iso8601.ParseDuration(tt.duration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.t.AddISODuration(d); !tt.want.Equal(got) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
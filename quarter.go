@@ -1,66 +1,214 @@
 package synchro
 
-import "time"
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// Quarter represents a quarter of a year in the timezone T, such as 2024-Q3.
+//
+// Quarter values can be compared with == and used as map keys.
 type Quarter[T TimeZone] struct {
 	year   int
 	number int
-	t      Time[T]
+	fc     FiscalCalendar
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*Quarter[tz.UTC])(nil)
+
+// NewQuarter returns the Quarter corresponding to the given year and number of quarter.
+//
+// The number may be outside its usual range and will be normalized during
+// the conversion. For example, the 5th quarter of 2023 converts to 2024-Q1.
+func NewQuarter[T TimeZone](year, number int) Quarter[T] {
+	return NewFiscalQuarter[T](FiscalCalendar{}, year, number)
+}
+
+// NewFiscalQuarter returns the Quarter corresponding to the given fiscal year and number
+// of quarter in the fiscal calendar fc.
+//
+// The number may be outside its usual range and will be normalized in the same
+// way as NewQuarter.
+func NewFiscalQuarter[T TimeZone](fc FiscalCalendar, year, number int) Quarter[T] {
+	year, number = normalizePeriod(year, number, 4)
+	return Quarter[T]{
+		year:   year,
+		number: number,
+		fc:     fc.normalize(),
+	}
 }
 
 // Quarter gets current quarter.
 func (t Time[T]) Quarter() Quarter[T] {
-	return Quarter[T]{
-		year:   t.Year(),
-		number: numberOfQuarter(t.Month()),
-		t:      t,
+	return t.FiscalQuarter(FiscalCalendar{})
+}
+
+// ParseQuarter parses a quarter string and returns the Quarter it represents.
+// Supported formats include:
+//
+//	Basic           Extended
+//	2024Q3          2024-Q3
+//
+// A fiscal quarter is represented as "FY2024-Q3@2024-04", where "2024-04" is
+// the year and month in which the fiscal year starts. It is the format of
+// MarshalText for fiscal quarters.
+func ParseQuarter[T TimeZone](value string) (Quarter[T], error) {
+	fc, year, number, err := parseFiscalPeriod(value, 'Q', "1-digit quarter")
+	if err != nil {
+		return Quarter[T]{}, err
+	}
+	if number < 1 || number > 4 {
+		return Quarter[T]{}, &iso8601.DateLikeRangeError{
+			Element: "quarter",
+			Value:   number,
+			Year:    year,
+			Min:     1,
+			Max:     4,
+		}
 	}
+	return NewFiscalQuarter[T](fc, year, number), nil
 }
 
 // Year returns the year in which q occurs.
+// For a fiscal quarter, it is the label of the fiscal year.
 func (q Quarter[T]) Year() int { return q.year }
 
 // Number returns the number of quarter.
 func (q Quarter[T]) Number() int { return q.number }
 
+// FiscalYear returns the fiscal year in which q occurs. For a calendar quarter,
+// the fiscal calendar is the zero value.
+func (q Quarter[T]) FiscalYear() FiscalYear[T] {
+	return FiscalYear[T]{year: q.year, fc: q.fc}
+}
+
 // Start returns start time in the quarter.
-func (q Quarter[T]) Start() Time[T] { return q.t.StartOfQuarter() }
+func (q Quarter[T]) Start() Time[T] {
+	return NewYearMonth[T](q.fc.yearMonth(q.year, 3*(q.number-1))).Start()
+}
 
 // End returns end time in the quarter.
-func (q Quarter[T]) End() Time[T] { return q.t.EndOfQuarter() }
+func (q Quarter[T]) End() Time[T] {
+	return NewYearMonth[T](q.fc.yearMonth(q.year, 3*q.number-1)).End()
+}
+
+// Days returns an iterator which yields the start of each day in the quarter.
+func (q Quarter[T]) Days() *Iterator[T] {
+	return Range(q.Start(), q.End(), iso8601.Duration{Day: 1})
+}
+
+// Contains reports whether t occurs in q.
+func (q Quarter[T]) Contains(t Time[T]) bool {
+	return t.FiscalQuarter(q.fc) == q
+}
+
+// Add returns the quarter n quarters after q. n may be negative.
+func (q Quarter[T]) Add(n int) Quarter[T] {
+	return NewFiscalQuarter[T](q.fc, q.year, q.number+n)
+}
+
+// Next returns the quarter after q.
+func (q Quarter[T]) Next() Quarter[T] { return q.Add(1) }
+
+// Prev returns the quarter before q.
+func (q Quarter[T]) Prev() Quarter[T] { return q.Add(-1) }
+
+// Sub returns the number of quarters q-u.
+//
+// If q and u are in different fiscal calendars, it returns the number of
+// whole quarters between their start times, truncated toward zero.
+func (q Quarter[T]) Sub(u Quarter[T]) int {
+	return (q.months() - u.months()) / 3
+}
 
 // After reports whether the Quarter instant q is after u.
+// Quarters in different fiscal calendars are compared by their start times.
 func (q Quarter[T]) After(u Quarter[T]) bool {
-	if q.year > u.year {
-		return true
-	}
-	if q.number > u.number {
-		return true
-	}
-	return false
+	return q.months() > u.months()
 }
 
 // Before reports whether the Quarter instant q is before u.
+// Quarters in different fiscal calendars are compared by their start times.
 func (q Quarter[T]) Before(u Quarter[T]) bool {
-	if q.year < u.year {
-		return true
-	}
-	if q.number < u.number {
-		return true
-	}
-	return false
+	return q.months() < u.months()
 }
 
 // Compare compares the Quarter instant q with u. If q is before u, it returns -1;
 // if q is after u, it returns +1; if they're the same, it returns 0.
+//
+// Quarters in different fiscal calendars are compared by their start times,
+// so Compare returns 0 for the quarters which start in the same month even
+// though they are different with ==.
 func (q Quarter[T]) Compare(u Quarter[T]) int {
-	if q.year == u.year && q.number == u.number {
-		return 0
-	}
-	if q.Before(u) {
+	switch m, n := q.months(), u.months(); {
+	case m < n:
 		return -1
+	case m > n:
+		return 1
 	}
-	return 1
+	return 0
+}
+
+// months returns the number of months from January of year 0 to the start of q.
+func (q Quarter[T]) months() int {
+	return q.fc.months(q.year, 3*(q.number-1))
+}
+
+// String returns the string representation of the format "YYYY-Qn".
+// For example: "2024-Q3". A fiscal quarter is prefixed with "FY", such as "FY2024-Q3".
+func (q Quarter[T]) String() string {
+	return q.fc.formatPeriod(q.year, q.number, 'Q', false)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The quarter is formatted in the same way as String, except that a fiscal
+// quarter is followed by '@' and the year and month in which the fiscal year
+// starts, such as "FY2024-Q3@2024-04", so that it can be unmarshaled.
+func (q Quarter[T]) MarshalText() ([]byte, error) {
+	return []byte(q.fc.formatPeriod(q.year, q.number, 'Q', true)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The quarter must be in a format supported by ParseQuarter.
+func (q *Quarter[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseQuarter[T](string(data))
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The quarter is a quoted string in the same format as MarshalText.
+func (q Quarter[T]) MarshalJSON() ([]byte, error) {
+	b, _ := q.MarshalText()
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The quarter must be a quoted string in a format supported by ParseQuarter.
+func (q *Quarter[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return q.UnmarshalText([]byte(s))
 }
 
 func numberOfQuarter(month time.Month) int {
@@ -75,3 +223,16 @@ func numberOfQuarter(month time.Month) int {
 	}
 	return 1
 }
+
+// normalizePeriod normalizes the number of the period in a year, which has n periods,
+// into the range [1,n] by carrying over to the year.
+func normalizePeriod(year, number, n int) (int, int) {
+	i := number - 1
+	year += i / n
+	i %= n
+	if i < 0 {
+		year--
+		i += n
+	}
+	return year, i + 1
+}
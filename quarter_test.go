@@ -3,6 +3,7 @@ package synchro_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Code-Hex/synchro"
 	"github.com/Code-Hex/synchro/tz"
@@ -81,3 +82,92 @@ func ExampleQuarter_Compare() {
 	// q1.Compare(q3) = -1
 	// q4.Compare(q1) = 1
 }
+
+func ExampleParseQuarter() {
+	q, _ := synchro.ParseQuarter[tz.UTC]("2024-Q1")
+	fmt.Println(q.Prev(), q, q.Next(), q.Add(-5))
+	// Output:
+	// 2023-Q4 2024-Q1 2024-Q2 2022-Q4
+}
+
+func TestNewQuarter(t *testing.T) {
+	tests := []struct {
+		year, number int
+		want         string
+	}{
+		{year: 2024, number: 3, want: "2024-Q3"},
+		{year: 2023, number: 5, want: "2024-Q1"},
+		{year: 2024, number: 0, want: "2023-Q4"},
+		{year: 2024, number: -4, want: "2022-Q4"},
+		{year: 2024, number: 12, want: "2026-Q4"},
+	}
+	for _, tt := range tests {
+		q := synchro.NewQuarter[tz.UTC](tt.year, tt.number)
+		if got := q.String(); got != tt.want {
+			t.Errorf("NewQuarter(%d, %d): want %s but got %s", tt.year, tt.number, tt.want, got)
+		}
+	}
+	q := synchro.NewQuarter[tz.AsiaTokyo](2024, 3)
+	if want := synchro.New[tz.AsiaTokyo](2024, 7, 1, 0, 0, 0, 0); !want.Equal(q.Start()) {
+		t.Errorf("want start %v but got %v", want, q.Start())
+	}
+	if want := synchro.New[tz.AsiaTokyo](2024, 9, 30, 23, 59, 59, 999999999); !want.Equal(q.End()) {
+		t.Errorf("want end %v but got %v", want, q.End())
+	}
+	if !q.Contains(q.Start()) || !q.Contains(q.End()) || q.Contains(q.End().Add(1)) {
+		t.Errorf("unexpected Contains result")
+	}
+}
+
+func TestQuarter_Sub(t *testing.T) {
+	q1 := synchro.NewQuarter[tz.UTC](2023, 4)
+	q2 := synchro.NewQuarter[tz.UTC](2024, 1)
+	if got := q2.Sub(q1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := q1.Sub(q2.Add(4)); got != -5 {
+		t.Errorf("want -5 but got %d", got)
+	}
+	// The year takes precedence over the number of quarter.
+	if q1.Before(q2) != true || q1.After(q2) != false || q1.Compare(q2) != -1 {
+		t.Errorf("want %s before %s", q1, q2)
+	}
+}
+
+func TestParseQuarter(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.Quarter[tz.UTC]
+		wantErr bool
+	}{
+		{value: "2024-Q3", want: synchro.NewQuarter[tz.UTC](2024, 3)},
+		{value: "2024Q3", want: synchro.NewQuarter[tz.UTC](2024, 3)},
+		{value: "2024-Q0", wantErr: true},
+		{value: "2024-Q5", wantErr: true},
+		{value: "2024-H1", wantErr: true},
+		{value: "2024-Q12", wantErr: true},
+		{value: "FY2024-Q3@2024-04", want: synchro.NewFiscalQuarter[tz.UTC](synchro.FiscalCalendar{StartMonth: time.April}, 2024, 3)},
+		{value: "FY2025-Q1@2024-10", want: synchro.NewFiscalQuarter[tz.UTC](synchro.FiscalCalendar{StartMonth: time.October, Naming: synchro.FiscalYearNamedByEnd}, 2025, 1)},
+		{value: "FY2024-Q3", wantErr: true},
+		{value: "FY2024-Q5@2024-04", wantErr: true},
+		{value: "FY2024-Q3@2024-13", wantErr: true},
+		{value: "FY2024-Q3@2022-04", wantErr: true},
+		{value: "FY2024-Q3@2024-4", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := synchro.ParseQuarter[tz.UTC](tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: want error but got %s", tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: want %s but got %s", tt.value, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package synchro
+
+import (
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+type rangeOptions struct {
+	inclusive bool
+}
+
+// RangeOptions is a function type that modifies the behavior of Range and
+// RangeDuration. It acts as a functional option.
+type RangeOptions func(*rangeOptions)
+
+// WithInclusiveEnd is an option to include the end of the range
+// if it is reached exactly.
+//
+// By default, the end of the range is excluded.
+func WithInclusiveEnd() RangeOptions {
+	return func(o *rangeOptions) {
+		o.inclusive = true
+	}
+}
+
+// Range returns an iterator which yields Time[T] values from "from" to "to"
+// by the given step. By default, "to" is excluded. Use WithInclusiveEnd to include it.
+//
+// The step is applied calendar-correctly in the timezone T with Time.AddISODuration,
+// so it can be calendar units such as a day (P1D), a week (P1W) or a month (P1M).
+// The k-th value is calculated as from + step * k, so month-end clamping does not
+// accumulate. For example, stepping by P1M from January 31 yields January 31,
+// February 29 and March 31.
+//
+// If the step is negative, the values are yielded backwards while they are
+// after "to". If the step does not move the time forward or backward,
+// the iterator yields nothing.
+func Range[T TimeZone](from, to Time[T], step iso8601.Duration, opts ...RangeOptions) *Iterator[T] {
+	o := new(rangeOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	direction := from.AddISODuration(step).Compare(from)
+	k := 0
+	return newIterator(func() (Time[T], bool) {
+		if direction == 0 {
+			return Time[T]{}, false
+		}
+		t := from.AddISODuration(scaleISODuration(step, k))
+		switch c := t.Compare(to) * direction; {
+		case c > 0, c == 0 && !o.inclusive:
+			return Time[T]{}, false
+		}
+		k++
+		return t, true
+	})
+}
+
+// RangeDuration is like Range but steps by the elapsed time d.
+//
+// For example, stepping by 24 * time.Hour across a daylight saving time
+// transition yields different wall-clock times, while stepping by P1D with
+// Range keeps the wall-clock time.
+func RangeDuration[T TimeZone](from, to Time[T], d time.Duration, opts ...RangeOptions) *Iterator[T] {
+	return Range(from, to, elapsedISODuration(d), opts...)
+}
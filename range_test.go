@@ -0,0 +1,165 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleRange() {
+	from := synchro.New[tz.UTC](2024, 1, 31, 0, 0, 0, 0)
+	to := synchro.New[tz.UTC](2024, 4, 30, 0, 0, 0, 0)
+	it := synchro.Range(from, to, iso8601.Duration{Month: 1})
+	for it.Next() {
+		fmt.Println(it.Time())
+	}
+	// Output:
+	// 2024-01-31 00:00:00 +0000 UTC
+	// 2024-02-29 00:00:00 +0000 UTC
+	// 2024-03-31 00:00:00 +0000 UTC
+}
+
+func TestRange(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	tests := []struct {
+		name     string
+		from, to synchro.Time[NY]
+		step     iso8601.Duration
+		opts     []synchro.RangeOptions
+		want     []synchro.Time[NY]
+	}{
+		{
+			name: "exclusive end",
+			from: synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 3, 0, 0, 0, 0),
+			step: iso8601.Duration{Day: 1},
+			want: []synchro.Time[NY]{
+				synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+				synchro.New[NY](2024, 1, 2, 0, 0, 0, 0),
+			},
+		},
+		{
+			name: "inclusive end",
+			from: synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 3, 0, 0, 0, 0),
+			step: iso8601.Duration{Day: 1},
+			opts: []synchro.RangeOptions{synchro.WithInclusiveEnd()},
+			want: []synchro.Time[NY]{
+				synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+				synchro.New[NY](2024, 1, 2, 0, 0, 0, 0),
+				synchro.New[NY](2024, 1, 3, 0, 0, 0, 0),
+			},
+		},
+		{
+			name: "inclusive end is not reached exactly",
+			from: synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 20, 0, 0, 0, 0),
+			step: iso8601.Duration{Week: 1},
+			opts: []synchro.RangeOptions{synchro.WithInclusiveEnd()},
+			want: []synchro.Time[NY]{
+				synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+				synchro.New[NY](2024, 1, 8, 0, 0, 0, 0),
+				synchro.New[NY](2024, 1, 15, 0, 0, 0, 0),
+			},
+		},
+		{
+			name: "keep wall-clock time across DST",
+			from: synchro.New[NY](2024, 3, 9, 12, 0, 0, 0),
+			to:   synchro.New[NY](2024, 3, 12, 0, 0, 0, 0),
+			step: iso8601.Duration{Day: 1},
+			want: []synchro.Time[NY]{
+				synchro.New[NY](2024, 3, 9, 12, 0, 0, 0),
+				synchro.New[NY](2024, 3, 10, 12, 0, 0, 0),
+				synchro.New[NY](2024, 3, 11, 12, 0, 0, 0),
+			},
+		},
+		{
+			name: "backward",
+			from: synchro.New[NY](2024, 3, 31, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 31, 0, 0, 0, 0),
+			step: iso8601.Duration{Month: 1, Negative: true},
+			want: []synchro.Time[NY]{
+				synchro.New[NY](2024, 3, 31, 0, 0, 0, 0),
+				synchro.New[NY](2024, 2, 29, 0, 0, 0, 0),
+			},
+		},
+		{
+			name: "step in the wrong direction",
+			from: synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 3, 0, 0, 0, 0),
+			step: iso8601.Duration{Day: 1, Negative: true},
+			want: nil,
+		},
+		{
+			name: "zero step",
+			from: synchro.New[NY](2024, 1, 1, 0, 0, 0, 0),
+			to:   synchro.New[NY](2024, 1, 3, 0, 0, 0, 0),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := synchro.Range(tt.from, tt.to, tt.step, tt.opts...).Take(100)
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %v but got %v", tt.want, got)
+			}
+			for i := range tt.want {
+				if !tt.want[i].Equal(got[i]) {
+					t.Errorf("[%d] want %v but got %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRangeDuration(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	from := synchro.New[NY](2024, 3, 9, 12, 0, 0, 0)
+	to := synchro.New[NY](2024, 3, 11, 13, 0, 0, 0)
+	got := synchro.RangeDuration(from, to, 24*time.Hour, synchro.WithInclusiveEnd()).Take(100)
+	want := []synchro.Time[NY]{
+		synchro.New[NY](2024, 3, 9, 12, 0, 0, 0),
+		synchro.New[NY](2024, 3, 10, 13, 0, 0, 0),
+		synchro.New[NY](2024, 3, 11, 13, 0, 0, 0),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %v but got %v", want, got)
+	}
+	for i := range want {
+		if !want[i].Equal(got[i]) {
+			t.Errorf("[%d] want %v but got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestQuarter_Days(t *testing.T) {
+	q := synchro.New[tz.AsiaTokyo](2024, 2, 10, 12, 0, 0, 0).Quarter()
+	days := q.Days().Take(100)
+	if want := 31 + 29 + 31; len(days) != want {
+		t.Fatalf("want %d days but got %d", want, len(days))
+	}
+	if want := synchro.New[tz.AsiaTokyo](2024, 1, 1, 0, 0, 0, 0); !want.Equal(days[0]) {
+		t.Errorf("want %v but got %v", want, days[0])
+	}
+	if want := synchro.New[tz.AsiaTokyo](2024, 3, 31, 0, 0, 0, 0); !want.Equal(days[len(days)-1]) {
+		t.Errorf("want %v but got %v", want, days[len(days)-1])
+	}
+}
+
+func TestSemester_Days(t *testing.T) {
+	s := synchro.New[tz.AsiaTokyo](2023, 8, 10, 12, 0, 0, 0).Semester()
+	days := s.Days().Take(1000)
+	if want := 184; len(days) != want {
+		t.Fatalf("want %d days but got %d", want, len(days))
+	}
+	if want := synchro.New[tz.AsiaTokyo](2023, 7, 1, 0, 0, 0, 0); !want.Equal(days[0]) {
+		t.Errorf("want %v but got %v", want, days[0])
+	}
+	if want := synchro.New[tz.AsiaTokyo](2023, 12, 31, 0, 0, 0, 0); !want.Equal(days[len(days)-1]) {
+		t.Errorf("want %v but got %v", want, days[len(days)-1])
+	}
+}
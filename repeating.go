@@ -0,0 +1,108 @@
+package synchro
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// RepeatingInterval represents an ISO8601 repeating time interval in the timezone T,
+// such as "R5/2024-01-01T00:00:00Z/P1D".
+type RepeatingInterval[T TimeZone] struct {
+	repetitions int
+	interval    iso8601.Interval
+	// anchor is the start of the interval, or the end of
+	// the interval if it is expressed as <duration>/<end>.
+	anchor Time[T]
+	step   iso8601.Duration
+}
+
+var _ fmt.Stringer = RepeatingInterval[tz.UTC]{}
+
+// ParseRepeatingInterval parses an ISO8601-compliant repeating time interval
+// string and returns the RepeatingInterval it represents. Supported formats include:
+//
+//	Rn/<interval>   R5/2024-01-01T00:00:00Z/P1D
+//	R/<interval>    R/2024-01-01T00:00:00Z/P1D
+//
+// The interval can be in any format supported by ParseInterval.
+// If the number of repetitions is omitted, the repeating interval is unbounded.
+func ParseRepeatingInterval[T TimeZone](value string) (RepeatingInterval[T], error) {
+	var tz T
+	r, err := iso8601.ParseRepeatingInterval(value, iso8601.WithInLocation(tz.Location()))
+	if err != nil {
+		return RepeatingInterval[T]{}, err
+	}
+	i := r.Interval
+	ri := RepeatingInterval[T]{
+		repetitions: r.Repetitions,
+		step:        i.Duration,
+	}
+	switch {
+	case !i.HasEnd():
+		ri.anchor = In[T](i.Start)
+		i.Start = ri.anchor.StdTime()
+	case !i.HasStart():
+		ri.anchor = In[T](i.End)
+		i.End = ri.anchor.StdTime()
+	default:
+		interval, err := NewInterval(In[T](i.Start), In[T](i.End))
+		if err != nil {
+			return RepeatingInterval[T]{}, err
+		}
+		ri.anchor = interval.Start()
+		ri.step = elapsedISODuration(interval.Duration())
+		i = interval.ISOInterval()
+	}
+	ri.interval = i
+	return ri, nil
+}
+
+// Repetitions returns the number of the intervals.
+// It returns -1 if the repeating interval is unbounded.
+func (r RepeatingInterval[T]) Repetitions() int { return r.repetitions }
+
+// IsUnbounded reports whether the repeating interval is unbounded.
+func (r RepeatingInterval[T]) IsUnbounded() bool { return r.repetitions < 0 }
+
+// Occurrences returns an iterator over the start times of each interval.
+//
+// The k-th occurrence is calculated by adding the duration multiplied by k
+// to the start with Time.AddISODuration, so the duration is applied
+// calendar-correctly in the timezone T and month-end clamping does not accumulate.
+// For example, "R/2024-01-31T00:00:00Z/P1M" yields January 31, February 29,
+// March 31 and so on.
+//
+// If the interval is expressed as <duration>/<end>, the last interval ends at the end.
+// In this case, a bounded repeating interval yields the occurrences in chronological
+// order, but an unbounded repeating interval yields them backwards from the end.
+func (r RepeatingInterval[T]) Occurrences() *Iterator[T] {
+	backward := !r.interval.HasStart()
+	k := 0
+	return newIterator(func() (Time[T], bool) {
+		if !r.IsUnbounded() && k >= r.repetitions {
+			return Time[T]{}, false
+		}
+		n := k
+		if backward {
+			n = -(k + 1)
+			if !r.IsUnbounded() {
+				n = k - r.repetitions
+			}
+		}
+		k++
+		return r.anchor.AddISODuration(scaleISODuration(r.step, n)), true
+	})
+}
+
+// String returns the ISO8601 string representation of the repeating interval.
+// For example: "R5/2024-01-01T00:00:00Z/P1D".
+func (r RepeatingInterval[T]) String() string {
+	repetitions := ""
+	if !r.IsUnbounded() {
+		repetitions = strconv.Itoa(r.repetitions)
+	}
+	return "R" + repetitions + "/" + r.interval.String()
+}
@@ -0,0 +1,117 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleRepeatingInterval_Occurrences() {
+	r, _ := synchro.ParseRepeatingInterval[tz.UTC]("R3/2024-01-31T00:00:00Z/P1M")
+	it := r.Occurrences()
+	for it.Next() {
+		fmt.Println(it.Time())
+	}
+	// Output:
+	// 2024-01-31 00:00:00 +0000 UTC
+	// 2024-02-29 00:00:00 +0000 UTC
+	// 2024-03-31 00:00:00 +0000 UTC
+}
+
+func TestRepeatingInterval_Occurrences(t *testing.T) {
+	tests := []struct {
+		value string
+		take  int
+		want  []synchro.Time[tz.AmericaNew_York]
+	}{
+		{
+			value: "R3/2024-03-09T12:00:00-05:00/P1D",
+			take:  10,
+			want: []synchro.Time[tz.AmericaNew_York]{
+				synchro.New[tz.AmericaNew_York](2024, 3, 9, 12, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 3, 10, 12, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 3, 11, 12, 0, 0, 0),
+			},
+		},
+		{
+			value: "R/2024-03-09T12:00:00-05:00/PT24H",
+			take:  2,
+			want: []synchro.Time[tz.AmericaNew_York]{
+				synchro.New[tz.AmericaNew_York](2024, 3, 9, 12, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 3, 10, 13, 0, 0, 0),
+			},
+		},
+		{
+			value: "R2/2024-01-01T00:00:00-05:00/2024-01-01T06:00:00-05:00",
+			take:  10,
+			want: []synchro.Time[tz.AmericaNew_York]{
+				synchro.New[tz.AmericaNew_York](2024, 1, 1, 0, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 1, 1, 6, 0, 0, 0),
+			},
+		},
+		{
+			value: "R3/P1M/2024-04-30T00:00:00-04:00",
+			take:  10,
+			want: []synchro.Time[tz.AmericaNew_York]{
+				synchro.New[tz.AmericaNew_York](2024, 1, 30, 0, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 2, 29, 0, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 3, 30, 0, 0, 0, 0),
+			},
+		},
+		{
+			value: "R/P1D/2024-01-10T00:00:00-05:00",
+			take:  2,
+			want: []synchro.Time[tz.AmericaNew_York]{
+				synchro.New[tz.AmericaNew_York](2024, 1, 9, 0, 0, 0, 0),
+				synchro.New[tz.AmericaNew_York](2024, 1, 8, 0, 0, 0, 0),
+			},
+		},
+		{
+			value: "R0/2024-01-01T00:00:00Z/P1D",
+			take:  10,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			r, err := synchro.ParseRepeatingInterval[tz.AmericaNew_York](tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := r.Occurrences().Take(tt.take)
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %d occurrences but got %d: %v", len(tt.want), len(got), got)
+			}
+			for i := range got {
+				if !tt.want[i].Equal(got[i]) {
+					t.Errorf("[%d] want %s but got %s", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRepeatingInterval(t *testing.T) {
+	r, err := synchro.ParseRepeatingInterval[tz.AsiaTokyo]("R/2024-01-01T00:00:00Z/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.IsUnbounded() || r.Repetitions() != -1 {
+		t.Errorf("should be unbounded")
+	}
+	if want := "R/2024-01-01T09:00:00+09:00/P1D"; r.String() != want {
+		t.Errorf("want %q but got %q", want, r.String())
+	}
+
+	for _, value := range []string{
+		"R5/2024-01-02T00:00:00Z/2024-01-01T00:00:00Z",
+		"R5/2024-01-01T00:00:00Z",
+		"2024-01-01T00:00:00Z/P1D",
+	} {
+		if _, err := synchro.ParseRepeatingInterval[tz.AsiaTokyo](value); err == nil {
+			t.Errorf("%s: expected error", value)
+		}
+	}
+}
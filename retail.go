@@ -0,0 +1,243 @@
+package synchro
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// RetailYearEndMethod represents how the last day of a retail year is determined.
+type RetailYearEndMethod int
+
+const (
+	// RetailLastOfMonth ends a retail year on the last YearEndWeekday
+	// of YearEndMonth.
+	RetailLastOfMonth RetailYearEndMethod = iota
+	// RetailNearestToMonthEnd ends a retail year on the YearEndWeekday
+	// nearest to the last day of YearEndMonth. It may fall in the next month.
+	RetailNearestToMonthEnd
+)
+
+// RetailPattern represents how the 13 weeks of a quarter are divided into
+// three periods in a retail calendar.
+type RetailPattern int
+
+const (
+	// Retail445 divides each quarter into periods of 4, 4 and 5 weeks.
+	Retail445 RetailPattern = iota
+	// Retail454 divides each quarter into periods of 4, 5 and 4 weeks.
+	Retail454
+	// Retail544 divides each quarter into periods of 5, 4 and 4 weeks.
+	Retail544
+)
+
+// weeks returns the number of weeks of each period in a quarter.
+func (p RetailPattern) weeks() [3]int {
+	switch p {
+	case Retail454:
+		return [3]int{4, 5, 4}
+	case Retail544:
+		return [3]int{5, 4, 4}
+	}
+	return [3]int{4, 4, 5}
+}
+
+// RetailCalendar represents a 52-53 week retail calendar, such as the NRF
+// 4-5-4 calendar. Every retail year consists of whole weeks and ends on
+// YearEndWeekday, so it has 52 or 53 weeks. It is divided into 4 quarters
+// of 13 weeks, and each quarter is divided into 3 periods by Pattern.
+// In 53-week years, the extra week is added to the last period.
+//
+// If YearEndMonth is not a valid month, December is used.
+type RetailCalendar struct {
+	YearEndMonth   time.Month
+	YearEndWeekday time.Weekday
+	Method         RetailYearEndMethod
+	Pattern        RetailPattern
+	// Naming is the labelling of retail years. Retail years are labelled as if
+	// they were fiscal years which start in the month after YearEndMonth.
+	Naming FiscalYearNaming
+}
+
+// NRFCalendar is the 4-5-4 retail calendar of the National Retail Federation.
+// A retail year ends on the Saturday nearest to the end of January, and is
+// labelled by the calendar year in which it starts.
+var NRFCalendar = RetailCalendar{
+	YearEndMonth:   time.January,
+	YearEndWeekday: time.Saturday,
+	Method:         RetailNearestToMonthEnd,
+	Pattern:        Retail454,
+	Naming:         FiscalYearNamedByStart,
+}
+
+func (rc RetailCalendar) yearEndMonth() time.Month {
+	if rc.YearEndMonth < time.January || rc.YearEndMonth > time.December {
+		return time.December
+	}
+	return rc.YearEndMonth
+}
+
+// yearEnd returns the last day of the retail year which nominally ends
+// in YearEndMonth of the calendar year.
+func (rc RetailCalendar) yearEnd(year int) Date[tz.UTC] {
+	month := rc.yearEndMonth()
+	last := NewDate[tz.UTC](year, month+1, 0)
+	back := (int(last.Weekday()) - int(rc.YearEndWeekday) + 7) % 7
+	if rc.Method == RetailNearestToMonthEnd && back > 3 {
+		return last.AddDays(7 - back)
+	}
+	return last.AddDays(-back)
+}
+
+// label returns the label of the retail year which nominally ends in the calendar year.
+func (rc RetailCalendar) label(year int) int {
+	fc := FiscalCalendar{StartMonth: rc.yearEndMonth()%12 + 1, Naming: rc.Naming}
+	fy, _ := fc.fiscalYear(year, rc.yearEndMonth())
+	return fy
+}
+
+// RetailDate represents a date in a retail calendar in the timezone T.
+type RetailDate[T TimeZone] struct {
+	rc   RetailCalendar
+	date Date[T]
+	// year is the calendar year in which the retail year nominally ends.
+	year  int
+	start Date[T]
+	weeks int
+}
+
+var _ fmt.Stringer = RetailDate[tz.UTC]{}
+
+// RetailDateOf returns the date d in the retail calendar rc.
+func RetailDateOf[T TimeZone](rc RetailCalendar, d Date[T]) RetailDate[T] {
+	u := Date[tz.UTC]{tm: d.tm}
+	year := d.Year()
+	if u.After(rc.yearEnd(year)) {
+		year++
+	} else if !u.After(rc.yearEnd(year - 1)) {
+		year--
+	}
+	start := rc.yearEnd(year - 1).AddDays(1)
+	end := rc.yearEnd(year)
+	return RetailDate[T]{
+		rc:    rc,
+		date:  d,
+		year:  year,
+		start: Date[T]{tm: start.tm},
+		weeks: (end.Sub(start) + 1) / 7,
+	}
+}
+
+// Retail returns the date on which t occurs in the retail calendar rc.
+func (t Time[T]) Retail(rc RetailCalendar) RetailDate[T] {
+	return RetailDateOf(rc, DateOf(t))
+}
+
+// Date returns the calendar date of r.
+func (r RetailDate[T]) Date() Date[T] { return r.date }
+
+// Year returns the label of the retail year in which r occurs.
+func (r RetailDate[T]) Year() int { return r.rc.label(r.year) }
+
+// Weeks returns the number of weeks, 52 or 53, in the retail year.
+func (r RetailDate[T]) Weeks() int { return r.weeks }
+
+// Week returns the week of the retail year in the range [1,53].
+func (r RetailDate[T]) Week() int { return r.date.Sub(r.start)/7 + 1 }
+
+// Day returns the day of the retail week in the range [1,7].
+// Retail weeks start on the day after YearEndWeekday.
+func (r RetailDate[T]) Day() int { return r.date.Sub(r.start)%7 + 1 }
+
+// Period returns the period of the retail year in the range [1,12].
+func (r RetailDate[T]) Period() int {
+	week := r.Week()
+	for p := 1; p < 12; p++ {
+		if week <= r.weeksBefore(p+1) {
+			return p
+		}
+	}
+	return 12
+}
+
+// Quarter returns the quarter of the retail year in the range [1,4].
+func (r RetailDate[T]) Quarter() int { return (r.Period()-1)/3 + 1 }
+
+// weeksBefore returns the number of weeks before the period p.
+// p may be 13, which returns the number of weeks in the retail year.
+func (r RetailDate[T]) weeksBefore(p int) int {
+	if p > 12 {
+		return r.weeks
+	}
+	pattern := r.rc.Pattern.weeks()
+	weeks := (p - 1) / 3 * 13
+	for i := 0; i < (p-1)%3; i++ {
+		weeks += pattern[i]
+	}
+	return weeks
+}
+
+// weekRange returns the start and end time of the weeks [from, to) in the
+// retail year. Weeks are zero-based.
+func (r RetailDate[T]) weekRange(from, to int) (Time[T], Time[T]) {
+	return r.start.AddDays(from * 7).StartOfDay(), r.start.AddDays(to*7 - 1).EndOfDay()
+}
+
+// YearStart returns start time in the retail year.
+func (r RetailDate[T]) YearStart() Time[T] {
+	start, _ := r.weekRange(0, r.weeks)
+	return start
+}
+
+// YearEnd returns end time in the retail year.
+func (r RetailDate[T]) YearEnd() Time[T] {
+	_, end := r.weekRange(0, r.weeks)
+	return end
+}
+
+// QuarterStart returns start time in the retail quarter.
+func (r RetailDate[T]) QuarterStart() Time[T] {
+	p := (r.Quarter()-1)*3 + 1
+	start, _ := r.weekRange(r.weeksBefore(p), r.weeksBefore(p+3))
+	return start
+}
+
+// QuarterEnd returns end time in the retail quarter.
+func (r RetailDate[T]) QuarterEnd() Time[T] {
+	p := (r.Quarter()-1)*3 + 1
+	_, end := r.weekRange(r.weeksBefore(p), r.weeksBefore(p+3))
+	return end
+}
+
+// PeriodStart returns start time in the retail period.
+func (r RetailDate[T]) PeriodStart() Time[T] {
+	p := r.Period()
+	start, _ := r.weekRange(r.weeksBefore(p), r.weeksBefore(p+1))
+	return start
+}
+
+// PeriodEnd returns end time in the retail period.
+func (r RetailDate[T]) PeriodEnd() Time[T] {
+	p := r.Period()
+	_, end := r.weekRange(r.weeksBefore(p), r.weeksBefore(p+1))
+	return end
+}
+
+// WeekStart returns start time in the retail week.
+func (r RetailDate[T]) WeekStart() Time[T] {
+	start, _ := r.weekRange(r.Week()-1, r.Week())
+	return start
+}
+
+// WeekEnd returns end time in the retail week.
+func (r RetailDate[T]) WeekEnd() Time[T] {
+	_, end := r.weekRange(r.Week()-1, r.Week())
+	return end
+}
+
+// String returns the string representation of the format "FYYYYY-Ppp-Www-d".
+// For example: "FY2024-P03-W11-3".
+func (r RetailDate[T]) String() string {
+	return fmt.Sprintf("FY%04d-P%02d-W%02d-%d", r.Year(), r.Period(), r.Week(), r.Day())
+}
@@ -0,0 +1,161 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_Retail() {
+	r := synchro.New[tz.AmericaNew_York](2024, 2, 3, 12, 0, 0, 0).Retail(synchro.NRFCalendar)
+	fmt.Println(r, r.Weeks())
+	fmt.Println(r.PeriodStart())
+	fmt.Println(r.YearEnd())
+	// Output:
+	// FY2023-P12-W53-7 53
+	// 2023-12-31 00:00:00 -0500 EST
+	// 2024-02-03 23:59:59.999999999 -0500 EST
+}
+
+func TestRetailDate(t *testing.T) {
+	lastOfDecember := synchro.RetailCalendar{
+		YearEndMonth:   time.December,
+		YearEndWeekday: time.Saturday,
+		Method:         synchro.RetailLastOfMonth,
+		Pattern:        synchro.Retail445,
+		Naming:         synchro.FiscalYearNamedByEnd,
+	}
+	tests := []struct {
+		name          string
+		rc            synchro.RetailCalendar
+		date          synchro.Date[tz.UTC]
+		want          string
+		wantQuarter   int
+		wantWeeks     int
+		wantYearStart synchro.Date[tz.UTC]
+		wantPeriod    [2]synchro.Date[tz.UTC]
+	}{
+		{
+			name:          "NRF first day",
+			rc:            synchro.NRFCalendar,
+			date:          synchro.NewDate[tz.UTC](2024, 2, 4),
+			want:          "FY2024-P01-W01-1",
+			wantQuarter:   1,
+			wantWeeks:     52,
+			wantYearStart: synchro.NewDate[tz.UTC](2024, 2, 4),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2024, 2, 4), synchro.NewDate[tz.UTC](2024, 3, 2)},
+		},
+		{
+			name:          "NRF 5-week period",
+			rc:            synchro.NRFCalendar,
+			date:          synchro.NewDate[tz.UTC](2024, 3, 31),
+			want:          "FY2024-P02-W09-1",
+			wantQuarter:   1,
+			wantWeeks:     52,
+			wantYearStart: synchro.NewDate[tz.UTC](2024, 2, 4),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2024, 3, 3), synchro.NewDate[tz.UTC](2024, 4, 6)},
+		},
+		{
+			name:          "NRF 53-week year before the year-end month",
+			rc:            synchro.NRFCalendar,
+			date:          synchro.NewDate[tz.UTC](2023, 1, 29),
+			want:          "FY2023-P01-W01-1",
+			wantQuarter:   1,
+			wantWeeks:     53,
+			wantYearStart: synchro.NewDate[tz.UTC](2023, 1, 29),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2023, 1, 29), synchro.NewDate[tz.UTC](2023, 2, 25)},
+		},
+		{
+			name:          "NRF 53rd week in the next month",
+			rc:            synchro.NRFCalendar,
+			date:          synchro.NewDate[tz.UTC](2024, 2, 1),
+			want:          "FY2023-P12-W53-5",
+			wantQuarter:   4,
+			wantWeeks:     53,
+			wantYearStart: synchro.NewDate[tz.UTC](2023, 1, 29),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2023, 12, 31), synchro.NewDate[tz.UTC](2024, 2, 3)},
+		},
+		{
+			name:          "last Saturday of December, end of year",
+			rc:            lastOfDecember,
+			date:          synchro.NewDate[tz.UTC](2023, 12, 30),
+			want:          "FY2023-P12-W52-7",
+			wantQuarter:   4,
+			wantWeeks:     52,
+			wantYearStart: synchro.NewDate[tz.UTC](2023, 1, 1),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2023, 11, 26), synchro.NewDate[tz.UTC](2023, 12, 30)},
+		},
+		{
+			name:          "last Saturday of December, next year",
+			rc:            lastOfDecember,
+			date:          synchro.NewDate[tz.UTC](2023, 12, 31),
+			want:          "FY2024-P01-W01-1",
+			wantQuarter:   1,
+			wantWeeks:     52,
+			wantYearStart: synchro.NewDate[tz.UTC](2023, 12, 31),
+			wantPeriod:    [2]synchro.Date[tz.UTC]{synchro.NewDate[tz.UTC](2023, 12, 31), synchro.NewDate[tz.UTC](2024, 1, 27)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := synchro.RetailDateOf(tt.rc, tt.date)
+			if got := r.String(); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+			if got := r.Quarter(); got != tt.wantQuarter {
+				t.Errorf("want quarter %d but got %d", tt.wantQuarter, got)
+			}
+			if got := r.Weeks(); got != tt.wantWeeks {
+				t.Errorf("want %d weeks but got %d", tt.wantWeeks, got)
+			}
+			if want := tt.wantYearStart.StartOfDay(); !want.Equal(r.YearStart()) {
+				t.Errorf("want year start %v but got %v", want, r.YearStart())
+			}
+			if want := tt.wantYearStart.AddDays(tt.wantWeeks * 7).StartOfDay().Add(-1); !want.Equal(r.YearEnd()) {
+				t.Errorf("want year end %v but got %v", want, r.YearEnd())
+			}
+			if want := tt.wantPeriod[0].StartOfDay(); !want.Equal(r.PeriodStart()) {
+				t.Errorf("want period start %v but got %v", want, r.PeriodStart())
+			}
+			if want := tt.wantPeriod[1].EndOfDay(); !want.Equal(r.PeriodEnd()) {
+				t.Errorf("want period end %v but got %v", want, r.PeriodEnd())
+			}
+			if want := tt.date.AddDays(1 - r.Day()).StartOfDay(); !want.Equal(r.WeekStart()) {
+				t.Errorf("want week start %v but got %v", want, r.WeekStart())
+			}
+			if want := tt.date.AddDays(7 - r.Day()).EndOfDay(); !want.Equal(r.WeekEnd()) {
+				t.Errorf("want week end %v but got %v", want, r.WeekEnd())
+			}
+		})
+	}
+}
+
+func TestRetailDate_Quarters(t *testing.T) {
+	for _, pattern := range []synchro.RetailPattern{synchro.Retail445, synchro.Retail454, synchro.Retail544} {
+		rc := synchro.NRFCalendar
+		rc.Pattern = pattern
+		r := synchro.RetailDateOf(rc, synchro.NewDate[tz.UTC](2023, 1, 29))
+		periods, quarters := 0, 0
+		prevPeriod, prevQuarter := 0, 0
+		for d := r.Date(); synchro.RetailDateOf(rc, d).Year() == 2023; d = d.AddDays(1) {
+			rd := synchro.RetailDateOf(rc, d)
+			if rd.Period() != prevPeriod {
+				periods++
+				prevPeriod = rd.Period()
+			}
+			if rd.Quarter() != prevQuarter {
+				quarters++
+				prevQuarter = rd.Quarter()
+				if got := rd.QuarterEnd().Sub(rd.QuarterStart()); got < 13*7*24*time.Hour-time.Nanosecond {
+					t.Errorf("pattern %d: quarter %d is too short: %v", pattern, rd.Quarter(), got)
+				}
+			}
+		}
+		if periods != 12 || quarters != 4 {
+			t.Errorf("pattern %d: want 12 periods and 4 quarters but got %d and %d", pattern, periods, quarters)
+		}
+	}
+}
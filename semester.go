@@ -1,66 +1,214 @@
 package synchro
 
-import "time"
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// Semester represents a half of a year in the timezone T, such as 2024-H2.
+//
+// Semester values can be compared with == and used as map keys.
 type Semester[T TimeZone] struct {
 	year   int
 	number int
-	t      Time[T]
+	fc     FiscalCalendar
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*Semester[tz.UTC])(nil)
+
+// NewSemester returns the Semester corresponding to the given year and number of semester.
+//
+// The number may be outside its usual range and will be normalized during
+// the conversion. For example, the 3rd semester of 2023 converts to 2024-H1.
+func NewSemester[T TimeZone](year, number int) Semester[T] {
+	return NewFiscalSemester[T](FiscalCalendar{}, year, number)
+}
+
+// NewFiscalSemester returns the Semester corresponding to the given fiscal year and number
+// of semester in the fiscal calendar fc.
+//
+// The number may be outside its usual range and will be normalized in the same
+// way as NewSemester.
+func NewFiscalSemester[T TimeZone](fc FiscalCalendar, year, number int) Semester[T] {
+	year, number = normalizePeriod(year, number, 2)
+	return Semester[T]{
+		year:   year,
+		number: number,
+		fc:     fc.normalize(),
+	}
 }
 
 // Quarter gets current semester.
 func (t Time[T]) Semester() Semester[T] {
-	return Semester[T]{
-		year:   t.Year(),
-		number: numberOfSemester(t.Month()),
-		t:      t,
+	return t.FiscalSemester(FiscalCalendar{})
+}
+
+// ParseSemester parses a semester (half-year) string and returns the Semester
+// it represents. Supported formats include:
+//
+//	Basic           Extended
+//	2024H2          2024-H2
+//
+// A fiscal semester is represented as "FY2024-H2@2024-04", where "2024-04" is
+// the year and month in which the fiscal year starts. It is the format of
+// MarshalText for fiscal semesters.
+func ParseSemester[T TimeZone](value string) (Semester[T], error) {
+	fc, year, number, err := parseFiscalPeriod(value, 'H', "1-digit semester")
+	if err != nil {
+		return Semester[T]{}, err
+	}
+	if number < 1 || number > 2 {
+		return Semester[T]{}, &iso8601.DateLikeRangeError{
+			Element: "semester",
+			Value:   number,
+			Year:    year,
+			Min:     1,
+			Max:     2,
+		}
 	}
+	return NewFiscalSemester[T](fc, year, number), nil
 }
 
 // Year returns the year in which s occurs.
-func (q Semester[T]) Year() int { return q.year }
+// For a fiscal semester, it is the label of the fiscal year.
+func (s Semester[T]) Year() int { return s.year }
 
 // Number returns the number of semester.
-func (q Semester[T]) Number() int { return q.number }
+func (s Semester[T]) Number() int { return s.number }
+
+// FiscalYear returns the fiscal year in which s occurs. For a calendar semester,
+// the fiscal calendar is the zero value.
+func (s Semester[T]) FiscalYear() FiscalYear[T] {
+	return FiscalYear[T]{year: s.year, fc: s.fc}
+}
 
 // Start returns start time in the semester.
-func (q Semester[T]) Start() Time[T] { return q.t.StartOfSemester() }
+func (s Semester[T]) Start() Time[T] {
+	return NewYearMonth[T](s.fc.yearMonth(s.year, 6*(s.number-1))).Start()
+}
 
 // End returns end time in the semester.
-func (q Semester[T]) End() Time[T] { return q.t.EndOfSemester() }
+func (s Semester[T]) End() Time[T] {
+	return NewYearMonth[T](s.fc.yearMonth(s.year, 6*s.number-1)).End()
+}
+
+// Days returns an iterator which yields the start of each day in the semester.
+func (s Semester[T]) Days() *Iterator[T] {
+	return Range(s.Start(), s.End(), iso8601.Duration{Day: 1})
+}
+
+// Contains reports whether t occurs in s.
+func (s Semester[T]) Contains(t Time[T]) bool {
+	return t.FiscalSemester(s.fc) == s
+}
+
+// Add returns the semester n semesters after s. n may be negative.
+func (s Semester[T]) Add(n int) Semester[T] {
+	return NewFiscalSemester[T](s.fc, s.year, s.number+n)
+}
+
+// Next returns the semester after s.
+func (s Semester[T]) Next() Semester[T] { return s.Add(1) }
+
+// Prev returns the semester before s.
+func (s Semester[T]) Prev() Semester[T] { return s.Add(-1) }
+
+// Sub returns the number of semesters s-u.
+//
+// If s and u are in different fiscal calendars, it returns the number of
+// whole semesters between their start times, truncated toward zero.
+func (s Semester[T]) Sub(u Semester[T]) int {
+	return (s.months() - u.months()) / 6
+}
 
 // After reports whether the Semester instant s is after u.
+// Semesters in different fiscal calendars are compared by their start times.
 func (s Semester[T]) After(u Semester[T]) bool {
-	if s.year > u.year {
-		return true
-	}
-	if s.number > u.number {
-		return true
-	}
-	return false
+	return s.months() > u.months()
 }
 
 // Before reports whether the Semester instant s is before u.
+// Semesters in different fiscal calendars are compared by their start times.
 func (s Semester[T]) Before(u Semester[T]) bool {
-	if s.year < u.year {
-		return true
-	}
-	if s.number < u.number {
-		return true
-	}
-	return false
+	return s.months() < u.months()
 }
 
 // Compare compares the Semester instant s with u. If s is before u, it returns -1;
 // if s is after u, it returns +1; if they're the same, it returns 0.
+//
+// Semesters in different fiscal calendars are compared by their start times,
+// so Compare returns 0 for the semesters which start in the same month even
+// though they are different with ==.
 func (s Semester[T]) Compare(u Semester[T]) int {
-	if s.year == u.year && s.number == u.number {
-		return 0
-	}
-	if s.Before(u) {
+	switch m, n := s.months(), u.months(); {
+	case m < n:
 		return -1
+	case m > n:
+		return 1
 	}
-	return 1
+	return 0
+}
+
+// months returns the number of months from January of year 0 to the start of s.
+func (s Semester[T]) months() int {
+	return s.fc.months(s.year, 6*(s.number-1))
+}
+
+// String returns the string representation of the format "YYYY-Hn".
+// For example: "2024-H2". A fiscal semester is prefixed with "FY", such as "FY2024-H2".
+func (s Semester[T]) String() string {
+	return s.fc.formatPeriod(s.year, s.number, 'H', false)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The semester is formatted in the same way as String, except that a fiscal
+// semester is followed by '@' and the year and month in which the fiscal year
+// starts, such as "FY2024-H2@2024-04", so that it can be unmarshaled.
+func (s Semester[T]) MarshalText() ([]byte, error) {
+	return []byte(s.fc.formatPeriod(s.year, s.number, 'H', true)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The semester must be in a format supported by ParseSemester.
+func (s *Semester[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseSemester[T](string(data))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The semester is a quoted string in the same format as MarshalText.
+func (s Semester[T]) MarshalJSON() ([]byte, error) {
+	b, _ := s.MarshalText()
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The semester must be a quoted string in a format supported by ParseSemester.
+func (s *Semester[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return s.UnmarshalText([]byte(str))
 }
 
 func numberOfSemester(month time.Month) int {
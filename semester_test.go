@@ -1,6 +1,7 @@
 package synchro_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -81,3 +82,71 @@ func ExampleSemester_Compare() {
 	// s1.Compare(s3) = -1
 	// s4.Compare(s1) = 1
 }
+
+func ExampleParseSemester() {
+	s, _ := synchro.ParseSemester[tz.UTC]("2024-H1")
+	fmt.Println(s.Prev(), s, s.Next(), s.Add(-3))
+	// Output:
+	// 2023-H2 2024-H1 2024-H2 2022-H2
+}
+
+func TestNewSemester(t *testing.T) {
+	tests := []struct {
+		year, number int
+		want         string
+	}{
+		{year: 2024, number: 2, want: "2024-H2"},
+		{year: 2023, number: 3, want: "2024-H1"},
+		{year: 2024, number: 0, want: "2023-H2"},
+		{year: 2024, number: -2, want: "2022-H2"},
+	}
+	for _, tt := range tests {
+		s := synchro.NewSemester[tz.UTC](tt.year, tt.number)
+		if got := s.String(); got != tt.want {
+			t.Errorf("NewSemester(%d, %d): want %s but got %s", tt.year, tt.number, tt.want, got)
+		}
+	}
+	s := synchro.NewSemester[tz.AsiaTokyo](2024, 2)
+	if want := synchro.New[tz.AsiaTokyo](2024, 7, 1, 0, 0, 0, 0); !want.Equal(s.Start()) {
+		t.Errorf("want start %v but got %v", want, s.Start())
+	}
+	if want := synchro.New[tz.AsiaTokyo](2024, 12, 31, 23, 59, 59, 999999999); !want.Equal(s.End()) {
+		t.Errorf("want end %v but got %v", want, s.End())
+	}
+	if !s.Contains(s.Start()) || !s.Contains(s.End()) || s.Contains(s.Start().Add(-1)) {
+		t.Errorf("unexpected Contains result")
+	}
+}
+
+func TestSemester_Sub(t *testing.T) {
+	s1 := synchro.NewSemester[tz.UTC](2023, 2)
+	s2 := synchro.NewSemester[tz.UTC](2024, 1)
+	if got := s2.Sub(s1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	// The year takes precedence over the number of semester.
+	if s1.Before(s2) != true || s1.After(s2) != false || s1.Compare(s2) != -1 {
+		t.Errorf("want %s before %s", s1, s2)
+	}
+}
+
+func TestSemester_JSON(t *testing.T) {
+	s := synchro.NewSemester[tz.UTC](2024, 2)
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"2024-H2"`; string(b) != want {
+		t.Errorf("want %s but got %s", want, b)
+	}
+	var got synchro.Semester[tz.UTC]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != s {
+		t.Errorf("want %s but got %s", s, got)
+	}
+	if err := json.Unmarshal([]byte(`"2024-H3"`), &got); err == nil {
+		t.Errorf("want error")
+	}
+}
@@ -16,6 +16,9 @@ var _ interface {
 } = (*Time[tz.UTC])(nil)
 
 // Scan implements the sql.Scanner interface.
+//
+// The string and []byte values are parsed with iso8601.LenientProfile,
+// which accepts ' ' as the time designator and the "UTC" suffix.
 func (t *Time[T]) Scan(src any) error {
 	if src == nil {
 		*t = Time[T]{} // zero value
@@ -29,7 +32,7 @@ func (t *Time[T]) Scan(src any) error {
 	case string:
 		parsed, err := iso8601.ParseDateTime[string](
 			s,
-			iso8601.WithTimeDesignators(' '),
+			iso8601.WithProfile(iso8601.LenientProfile),
 			iso8601.WithInLocation(tz.Location()),
 		)
 		if err != nil {
@@ -40,7 +43,7 @@ func (t *Time[T]) Scan(src any) error {
 	case []byte:
 		parsed, err := iso8601.ParseDateTime[[]byte](
 			s,
-			iso8601.WithTimeDesignators(' '),
+			iso8601.WithProfile(iso8601.LenientProfile),
 			iso8601.WithInLocation(tz.Location()),
 		)
 		if err != nil {
@@ -88,3 +91,263 @@ func (n NullTime[T]) Value() (driver.Value, error) {
 	}
 	return n.Time.tm, nil
 }
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*Date[tz.UTC])(nil)
+
+// Scan implements the sql.Scanner interface.
+//
+// When src is time.Time, its year, month and day are used as is,
+// without converting it to the timezone T. Database drivers usually
+// return DATE columns as midnight in UTC (or in the connection's location),
+// so converting would shift the date.
+func (d *Date[T]) Scan(src any) error {
+	if src == nil {
+		*d = Date[T]{} // zero value
+		return nil
+	}
+	switch s := src.(type) {
+	case time.Time:
+		*d = NewDate[T](s.Date())
+		return nil
+	case string:
+		parsed, err := ParseDate[T](s)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseDate[T](string(s))
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The date is returned as a string in the "YYYY-MM-DD" format.
+func (d Date[T]) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*NullDate[tz.UTC])(nil)
+
+// NullDate represents a Date[T] that may be null.
+// NullDate implements the sql.Scanner interface so
+// it can be used as a scan destination, similar to sql.NullString.
+type NullDate[T TimeZone] struct {
+	Date  Date[T]
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDate[T]) Scan(src any) error {
+	if src == nil {
+		n.Date, n.Valid = Date[T]{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Date.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDate[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*LocalDateTime)(nil)
+
+// Scan implements the sql.Scanner interface.
+//
+// When src is time.Time, its wall-clock reading is used as is,
+// without any timezone conversion.
+func (l *LocalDateTime) Scan(src any) error {
+	if src == nil {
+		*l = LocalDateTime{} // zero value
+		return nil
+	}
+	switch s := src.(type) {
+	case time.Time:
+		year, month, day := s.Date()
+		hour, min, sec := s.Clock()
+		*l = NewLocalDateTime(year, month, day, hour, min, sec, s.Nanosecond())
+		return nil
+	case string:
+		parsed, err := ParseLocalDateTime(s)
+		if err != nil {
+			return err
+		}
+		*l = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseLocalDateTime(string(s))
+		if err != nil {
+			return err
+		}
+		*l = parsed
+		return nil
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The value is returned as a string in the "YYYY-MM-DD hh:mm:ss" format
+// with sub-second precision if present.
+func (l LocalDateTime) Value() (driver.Value, error) {
+	return l.tm.Format("2006-01-02 15:04:05.999999999"), nil
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*NullLocalDateTime)(nil)
+
+// NullLocalDateTime represents a LocalDateTime that may be null.
+// NullLocalDateTime implements the sql.Scanner interface so
+// it can be used as a scan destination, similar to sql.NullString.
+type NullLocalDateTime struct {
+	LocalDateTime LocalDateTime
+	Valid         bool // Valid is true if LocalDateTime is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullLocalDateTime) Scan(src any) error {
+	if src == nil {
+		n.LocalDateTime, n.Valid = LocalDateTime{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.LocalDateTime.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullLocalDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.LocalDateTime.Value()
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*TimeOfDay)(nil)
+
+// Scan implements the sql.Scanner interface.
+// It can be used for SQL TIME columns.
+//
+// When src is time.Time, its wall-clock time within the day is used as is,
+// without any timezone conversion.
+func (t *TimeOfDay) Scan(src any) error {
+	if src == nil {
+		*t = TimeOfDay{} // zero value
+		return nil
+	}
+	switch s := src.(type) {
+	case time.Time:
+		hour, min, sec := s.Clock()
+		*t = NewTimeOfDay(hour, min, sec, s.Nanosecond())
+		return nil
+	case string:
+		parsed, err := ParseTimeOfDay(s)
+		if err != nil {
+			return err
+		}
+		*t = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseTimeOfDay(string(s))
+		if err != nil {
+			return err
+		}
+		*t = parsed
+		return nil
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The value is returned as a string in the "hh:mm:ss" format
+// with sub-second precision if present.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*Interval[tz.UTC])(nil)
+
+// Scan implements the sql.Scanner interface.
+// The value must be a string in a format supported by ParseInterval.
+func (i *Interval[T]) Scan(src any) error {
+	if src == nil {
+		*i = Interval[T]{} // zero value
+		return nil
+	}
+	switch s := src.(type) {
+	case string:
+		return i.UnmarshalText([]byte(s))
+	case []byte:
+		return i.UnmarshalText(s)
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The value is returned as a string which is the same as String.
+func (i Interval[T]) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*YearMonth[tz.UTC])(nil)
+
+// Scan implements the sql.Scanner interface.
+//
+// When src is time.Time, the year and month of its wall-clock reading
+// are used without any timezone conversion.
+func (ym *YearMonth[T]) Scan(src any) error {
+	if src == nil {
+		*ym = YearMonth[T]{} // zero value
+		return nil
+	}
+	switch s := src.(type) {
+	case time.Time:
+		*ym = NewYearMonth[T](s.Year(), s.Month())
+		return nil
+	case string:
+		return ym.UnmarshalText([]byte(s))
+	case []byte:
+		return ym.UnmarshalText(s)
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The month is returned as a string in the "YYYY-MM" format.
+func (ym YearMonth[T]) Value() (driver.Value, error) {
+	return ym.String(), nil
+}
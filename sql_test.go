@@ -53,6 +53,12 @@ func TestTime_Scan(t *testing.T) {
 				want: synchro.New[tz.UTC](2023, 9, 10, 14, 3, 54, 115898),
 				err:  false,
 			},
+			{
+				name: "timestamp with UTC suffix",
+				src:  "2023-09-10 14:03:54 UTC",
+				want: synchro.New[tz.UTC](2023, 9, 10, 14, 3, 54, 0),
+				err:  false,
+			},
 			{
 				name: "invalid format as string",
 				src:  "unknown",
@@ -292,3 +298,161 @@ func TestNullTime_Value(t *testing.T) {
 	})
 
 }
+
+func TestDate_Scan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+		want synchro.Date[tz.AmericaNew_York]
+		err  bool
+	}{
+		{
+			name: "nil",
+			src:  nil,
+			want: synchro.Date[tz.AmericaNew_York]{},
+		},
+		{
+			name: "time.Time as UTC midnight",
+			src:  time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC),
+			want: synchro.NewDate[tz.AmericaNew_York](2023, 9, 10),
+		},
+		{
+			name: "date as string",
+			src:  "2023-09-10",
+			want: synchro.NewDate[tz.AmericaNew_York](2023, 9, 10),
+		},
+		{
+			name: "date as bytes",
+			src:  []byte("2023-09-10"),
+			want: synchro.NewDate[tz.AmericaNew_York](2023, 9, 10),
+		},
+		{
+			name: "invalid format as string",
+			src:  "unknown",
+			err:  true,
+		},
+		{
+			name: "unknown type",
+			src:  123,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got synchro.Date[tz.AmericaNew_York]
+			err := got.Scan(tt.src)
+			if tt.err && err == nil {
+				t.Errorf("Scan(%v) should have returned an error, but did not", tt.src)
+			} else if !tt.err && err != nil {
+				t.Errorf("Scan(%v) returned an unexpected error: %v", tt.src, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDate_Value(t *testing.T) {
+	d := synchro.NewDate[tz.UTC](2023, 9, 10)
+	got, err := synchro.NullDate[tz.UTC]{Date: d, Valid: true}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023-09-10"; got != want {
+		t.Fatalf("want %q but got %q", want, got)
+	}
+	got, err = synchro.NullDate[tz.UTC]{Date: d}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("want nil but got %q", got)
+	}
+}
+
+func TestNullLocalDateTime(t *testing.T) {
+	var got synchro.NullLocalDateTime
+	if err := got.Scan(time.Date(2023, 9, 10, 8, 30, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	want := synchro.NewLocalDateTime(2023, 9, 10, 8, 30, 0, 0)
+	if !got.Valid || got.LocalDateTime != want {
+		t.Fatalf("want %v but got %v", want, got)
+	}
+	v, err := got.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023-09-10 08:30:00"; v != want {
+		t.Fatalf("want %q but got %q", want, v)
+	}
+
+	if err := got.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.Valid || got.LocalDateTime != (synchro.LocalDateTime{}) {
+		t.Fatalf("want null but got %v", got)
+	}
+	v, err = got.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Fatalf("want nil but got %q", v)
+	}
+}
+
+func TestYearMonth_Scan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+		want synchro.YearMonth[tz.AmericaNew_York]
+		err  bool
+	}{
+		{
+			name: "nil",
+			src:  nil,
+			want: synchro.YearMonth[tz.AmericaNew_York]{},
+		},
+		{
+			name: "time.Time",
+			src:  time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			want: synchro.NewYearMonth[tz.AmericaNew_York](2023, 9),
+		},
+		{
+			name: "month as string",
+			src:  "2023-09",
+			want: synchro.NewYearMonth[tz.AmericaNew_York](2023, 9),
+		},
+		{
+			name: "month as bytes",
+			src:  []byte("2023-09"),
+			want: synchro.NewYearMonth[tz.AmericaNew_York](2023, 9),
+		},
+		{
+			name: "invalid format as string",
+			src:  "unknown",
+			err:  true,
+		},
+		{
+			name: "unknown type",
+			src:  123,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got synchro.YearMonth[tz.AmericaNew_York]
+			err := got.Scan(tt.src)
+			if tt.err && err == nil {
+				t.Errorf("Scan(%v) should have returned an error, but did not", tt.src)
+			} else if !tt.err && err != nil {
+				t.Errorf("Scan(%v) returned an unexpected error: %v", tt.src, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
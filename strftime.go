@@ -0,0 +1,696 @@
+package synchro
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strftime returns a textual representation of the time value formatted
+// according to the strftime-style pattern. Names are written in the POSIX
+// ("C") locale.
+//
+// The following conversion specifications are supported:
+//
+//	%a  abbreviated weekday name (Sun)
+//	%A  full weekday name (Sunday)
+//	%b  abbreviated month name (Jan), same as %h
+//	%B  full month name (January)
+//	%c  date and time, same as "%a %b %e %H:%M:%S %Y"
+//	%C  century as a 2-digit number [00,99]
+//	%d  day of the month [01,31]
+//	%D  date, same as "%m/%d/%y"
+//	%e  day of the month padded with a space [ 1,31]
+//	%f  microseconds [000000,999999]
+//	%F  date, same as "%Y-%m-%d"
+//	%g  last 2 digits of the ISO 8601 week-based year [00,99]
+//	%G  ISO 8601 week-based year
+//	%H  hour of the 24-hour clock [00,23]
+//	%I  hour of the 12-hour clock [01,12]
+//	%j  day of the year [001,366]
+//	%m  month [01,12]
+//	%M  minute [00,59]
+//	%n  newline
+//	%p  AM or PM
+//	%r  time in the 12-hour clock, same as "%I:%M:%S %p"
+//	%R  time in the 24-hour clock, same as "%H:%M"
+//	%s  seconds since the Unix epoch
+//	%S  second [00,59]
+//	%t  tab
+//	%T  time, same as "%H:%M:%S"
+//	%u  weekday as a number [1,7], Monday is 1
+//	%U  week of the year [00,53], the first Sunday is the first day of week 1
+//	%V  ISO 8601 week of the year [01,53]
+//	%w  weekday as a number [0,6], Sunday is 0
+//	%W  week of the year [00,53], the first Monday is the first day of week 1
+//	%x  date, same as "%m/%d/%y"
+//	%X  time, same as "%H:%M:%S"
+//	%y  last 2 digits of the year [00,99]
+//	%Y  year
+//	%z  UTC offset in the form +hhmm
+//	%:z UTC offset in the form +hh:mm
+//	%Z  timezone abbreviation
+//	%%  a literal '%'
+//
+// Unknown conversion specifications are written as they are.
+func (t Time[T]) Strftime(pattern string) string {
+	return string(appendStrftime(nil, t.tm, pattern))
+}
+
+func appendStrftime(b []byte, tm time.Time, pattern string) []byte {
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' || i+1 == len(pattern) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		spec := pattern[i]
+		if spec == ':' && i+1 < len(pattern) && pattern[i+1] == 'z' {
+			i++
+			b = appendOffset(b, tm, true)
+			continue
+		}
+		if expanded, ok := strftimeComposites[spec]; ok {
+			b = appendStrftime(b, tm, expanded)
+			continue
+		}
+		switch spec {
+		case 'a':
+			b = append(b, tm.Weekday().String()[:3]...)
+		case 'A':
+			b = append(b, tm.Weekday().String()...)
+		case 'b', 'h':
+			b = append(b, tm.Month().String()[:3]...)
+		case 'B':
+			b = append(b, tm.Month().String()...)
+		case 'C':
+			b = appendPadded(b, floorDiv(tm.Year(), 100), 2, '0')
+		case 'd':
+			b = appendPadded(b, tm.Day(), 2, '0')
+		case 'e':
+			b = appendPadded(b, tm.Day(), 2, ' ')
+		case 'f':
+			b = appendPadded(b, tm.Nanosecond()/1000, 6, '0')
+		case 'g':
+			year, _ := tm.ISOWeek()
+			b = appendPadded(b, floorMod(year, 100), 2, '0')
+		case 'G':
+			year, _ := tm.ISOWeek()
+			b = appendPadded(b, year, 4, '0')
+		case 'H':
+			b = appendPadded(b, tm.Hour(), 2, '0')
+		case 'I':
+			b = appendPadded(b, hour12(tm.Hour()), 2, '0')
+		case 'j':
+			b = appendPadded(b, tm.YearDay(), 3, '0')
+		case 'm':
+			b = appendPadded(b, int(tm.Month()), 2, '0')
+		case 'M':
+			b = appendPadded(b, tm.Minute(), 2, '0')
+		case 'n':
+			b = append(b, '\n')
+		case 'p':
+			if tm.Hour() < 12 {
+				b = append(b, "AM"...)
+			} else {
+				b = append(b, "PM"...)
+			}
+		case 's':
+			b = strconv.AppendInt(b, tm.Unix(), 10)
+		case 'S':
+			b = appendPadded(b, tm.Second(), 2, '0')
+		case 't':
+			b = append(b, '\t')
+		case 'u':
+			b = strconv.AppendInt(b, int64((int(tm.Weekday())+6)%7+1), 10)
+		case 'U':
+			b = appendPadded(b, (tm.YearDay()+6-int(tm.Weekday()))/7, 2, '0')
+		case 'V':
+			_, week := tm.ISOWeek()
+			b = appendPadded(b, week, 2, '0')
+		case 'w':
+			b = strconv.AppendInt(b, int64(tm.Weekday()), 10)
+		case 'W':
+			b = appendPadded(b, (tm.YearDay()+6-(int(tm.Weekday())+6)%7)/7, 2, '0')
+		case 'y':
+			b = appendPadded(b, floorMod(tm.Year(), 100), 2, '0')
+		case 'Y':
+			b = appendPadded(b, tm.Year(), 4, '0')
+		case 'z':
+			b = appendOffset(b, tm, false)
+		case 'Z':
+			name, _ := tm.Zone()
+			b = append(b, name...)
+		case '%':
+			b = append(b, '%')
+		default:
+			b = append(b, '%', spec)
+		}
+	}
+	return b
+}
+
+// strftimeComposites are the conversion specifications which are the
+// shorthands of the other ones.
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+func appendPadded(b []byte, n, width int, pad byte) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	s := strconv.Itoa(n)
+	for i := len(s); i < width; i++ {
+		b = append(b, pad)
+	}
+	return append(b, s...)
+}
+
+func appendOffset(b []byte, tm time.Time, colon bool) []byte {
+	_, offset := tm.Zone()
+	sign := byte('+')
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	b = append(b, sign)
+	b = appendPadded(b, offset/3600, 2, '0')
+	if colon {
+		b = append(b, ':')
+	}
+	return appendPadded(b, offset/60%60, 2, '0')
+}
+
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
+
+// strptimeFields are the fields which are parsed by Strptime.
+type strptimeFields struct {
+	year, month, day, yday int
+	century, yearOfCentury int
+	hour, min, sec, nsec   int
+	pm                     int // 0: not specified, 1: AM, 2: PM
+	isoYear, isoWeek       int
+	weekOfYear             int // %U or %W
+	weekOfYearSpec         byte
+	weekday                int // 0: not specified, 1-7: Monday to Sunday
+	unix                   int64
+	offset                 int
+	has                    map[byte]bool
+}
+
+// Strptime parses a formatted string by the strftime-style pattern and returns
+// the time value it represents. The conversion specifications are the same as
+// Strftime, and names are matched case-insensitively in the POSIX ("C") locale.
+// A whitespace in the pattern matches zero or more whitespaces in the value.
+//
+// %z and %:z accept "Z", "+hh", "+hhmm" and "+hh:mm" as the UTC offset.
+// If the value has no UTC offset by them, the time is interpreted in the
+// timezone T. Otherwise the time is converted to the timezone T. %Z accepts
+// any timezone abbreviation but only "UTC", "GMT" and "Z" are used as the offset.
+// If the value has the seconds since the Unix epoch by %s, the other fields are ignored.
+//
+// The date can be also determined by the day of the year (%j), the ISO 8601 week
+// date (%G, %V and %u) or the week of the year (%U or %W, and %u, %w, %a or %A)
+// with the year. Elements omitted from the pattern are assumed to be zero or,
+// when zero is impossible, one, in the same way as time.Parse. A week which
+// cannot be resolved to a date, such as %V without %G or %U without a weekday,
+// is an error. The weekday of the ISO 8601 week date defaults to Monday.
+//
+// The error is *time.ParseError.
+func Strptime[T TimeZone](pattern, value string) (Time[T], error) {
+	f := &strptimeFields{has: make(map[byte]bool)}
+	if err := f.parse(pattern, value); err != nil {
+		return Time[T]{}, err
+	}
+	if f.has['s'] {
+		return In[T](time.Unix(f.unix, 0)), nil
+	}
+	year, month, day, err := f.date()
+	if err != nil {
+		return Time[T]{}, &time.ParseError{Layout: pattern, Value: value, Message: ": " + err.Error()}
+	}
+	hour := f.hour
+	switch f.pm {
+	case 1:
+		hour %= 12
+	case 2:
+		hour = hour%12 + 12
+	}
+	if f.has['z'] || f.has['Z'] {
+		tm := time.Date(year, month, day, hour, f.min, f.sec, f.nsec, time.FixedZone("", f.offset))
+		return In[T](tm), nil
+	}
+	return New[T](year, month, day, hour, f.min, f.sec, f.nsec), nil
+}
+
+// strptimeError is the error of the value which is out of range.
+type strptimeError string
+
+func (e strptimeError) Error() string { return string(e) }
+
+// errBadValue means that the value does not match the conversion specification.
+var errBadValue = errors.New("bad value")
+
+// date resolves the date from the parsed fields.
+func (f *strptimeFields) date() (int, time.Month, int, error) {
+	year := f.year
+	switch {
+	case f.has['Y']:
+	case f.has['C'] && f.has['y']:
+		year = f.century*100 + f.yearOfCentury
+	case f.has['C']:
+		year = f.century * 100
+	case f.has['y']:
+		year = 1900 + f.yearOfCentury
+		if f.yearOfCentury < 69 {
+			year += 100
+		}
+	}
+	// The weekday is Monday (1) to Sunday (7). If it is not specified,
+	// Monday is used for the ISO 8601 week date.
+	weekday := f.weekday
+	switch {
+	case f.has['G'] != f.has['V']:
+		return 0, 0, 0, strptimeError("ISO 8601 week without week-based year")
+	case f.weekOfYearSpec != 0 && weekday == 0 && !f.has['G']:
+		return 0, 0, 0, strptimeError("week of the year without weekday")
+	case weekday == 0:
+		weekday = 1
+	}
+	switch {
+	case f.has['G'] && f.has['V']:
+		if f.isoWeek > isoWeeksInYear(f.isoYear) {
+			return 0, 0, 0, strptimeError("week out of range")
+		}
+		// January 4th is always in the first week.
+		jan4 := time.Date(f.isoYear, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		d := monday.AddDate(0, 0, (f.isoWeek-1)*7+weekday-1)
+		return d.Year(), d.Month(), d.Day(), nil
+	case f.weekOfYearSpec != 0:
+		jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		first := 1 // the first day of the week, Monday for %W.
+		if f.weekOfYearSpec == 'U' {
+			first = 7
+		}
+		// The first day of week 1.
+		week1 := jan1.AddDate(0, 0, ((first%7)-int(jan1.Weekday())+7)%7)
+		d := week1.AddDate(0, 0, (f.weekOfYear-1)*7+(weekday-first+7)%7)
+		if d.Year() != year {
+			return 0, 0, 0, strptimeError("week out of range")
+		}
+		return d.Year(), d.Month(), d.Day(), nil
+	case f.has['j']:
+		if f.yday > 365+btoi(daysIn(year, time.February) == 29) {
+			return 0, 0, 0, strptimeError("day-of-year out of range")
+		}
+		d := time.Date(year, time.January, f.yday, 0, 0, 0, 0, time.UTC)
+		return d.Year(), d.Month(), d.Day(), nil
+	}
+	month, day := time.Month(f.month), f.day
+	if !f.has['m'] {
+		month = time.January
+	}
+	if !f.has['d'] {
+		day = 1
+	}
+	if day > daysIn(year, month) {
+		return 0, 0, 0, strptimeError("day out of range")
+	}
+	return year, month, day, nil
+}
+
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var (
+	shortWeekdayNames = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+	longWeekdayNames  = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+	shortMonthNames   = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	longMonthNames    = []string{"january", "february", "march", "april", "may", "june", "july", "august", "september", "october", "november", "december"}
+)
+
+// lookupName returns the 1-based index of the longest name which is the prefix
+// of value case-insensitively.
+func lookupName(value string, names ...[]string) (int, string, bool) {
+	for _, tab := range names {
+		for i, name := range tab {
+			if len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+				return i + 1, value[len(name):], true
+			}
+		}
+	}
+	return 0, value, false
+}
+
+func (f *strptimeFields) parse(pattern, value string) error {
+	orig := value
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if isSpace(c) {
+			value = strings.TrimLeft(value, " \t\n\r\v\f")
+			continue
+		}
+		if c != '%' || i+1 == len(pattern) {
+			if value == "" || value[0] != c {
+				return &time.ParseError{Layout: pattern, Value: orig, LayoutElem: pattern[i:], ValueElem: value}
+			}
+			value = value[1:]
+			continue
+		}
+		elem := pattern[i : i+2]
+		i++
+		spec := pattern[i]
+		if spec == ':' && i+1 < len(pattern) && pattern[i+1] == 'z' {
+			elem = pattern[i-1 : i+2]
+			i++
+			spec = 'z'
+		}
+		var (
+			rest string
+			err  error
+		)
+		if expanded, ok := strftimeComposites[spec]; ok {
+			rest, err = f.parseComposite(expanded, value)
+		} else {
+			rest, err = f.parseSpec(spec, value)
+		}
+		if err != nil {
+			if msg, ok := err.(strptimeError); ok {
+				return &time.ParseError{Layout: pattern, Value: orig, Message: ": " + string(msg)}
+			}
+			return &time.ParseError{Layout: pattern, Value: orig, LayoutElem: elem, ValueElem: value}
+		}
+		value = rest
+	}
+	if value != "" {
+		return &time.ParseError{Layout: pattern, Value: orig, Message: ": extra text: " + strconv.Quote(value)}
+	}
+	return nil
+}
+
+// parseComposite parses the value by the pattern of the composite conversion
+// specification and returns the rest of the value.
+func (f *strptimeFields) parseComposite(pattern, value string) (string, error) {
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' {
+			if isSpace(c) {
+				value = strings.TrimLeft(value, " \t\n\r\v\f")
+				continue
+			}
+			if value == "" || value[0] != c {
+				return value, errBadValue
+			}
+			value = value[1:]
+			continue
+		}
+		i++
+		rest, err := f.parseSpec(pattern[i], value)
+		if err != nil {
+			return value, err
+		}
+		value = rest
+	}
+	return value, nil
+}
+
+// parseSpec parses the value by the conversion specification and returns the rest of the value.
+func (f *strptimeFields) parseSpec(spec byte, value string) (string, error) {
+	var (
+		n    int
+		rest string
+		ok   bool
+	)
+	number := func(width, lo, hi int, name string) error {
+		n, rest, ok = parseStrptimeNumber(value, width)
+		if !ok {
+			return errBadValue
+		}
+		if n < lo || n > hi {
+			return strptimeError(name + " out of range")
+		}
+		return nil
+	}
+	f.has[spec] = true
+	switch spec {
+	case 'a', 'A':
+		n, rest, ok = lookupName(value, longWeekdayNames, shortWeekdayNames)
+		if !ok {
+			return value, errBadValue
+		}
+		f.weekday = n
+	case 'b', 'B', 'h':
+		n, rest, ok = lookupName(value, longMonthNames, shortMonthNames)
+		if !ok {
+			return value, errBadValue
+		}
+		f.month = n
+		f.has['m'] = true
+	case 'C':
+		if err := number(2, 0, 99, "century"); err != nil {
+			return value, err
+		}
+		f.century = n
+	case 'd', 'e':
+		if spec == 'e' {
+			value = strings.TrimLeft(value, " ")
+		}
+		if err := number(2, 1, 31, "day"); err != nil {
+			return value, err
+		}
+		f.day = n
+		f.has['d'] = true
+	case 'f':
+		digits := 0
+		for digits < len(value) && digits < 9 && isDigit(value[digits]) {
+			digits++
+		}
+		if digits == 0 {
+			return value, errBadValue
+		}
+		n, _ = strconv.Atoi(value[:digits])
+		for i := digits; i < 9; i++ {
+			n *= 10
+		}
+		f.nsec, rest = n, value[digits:]
+	case 'g':
+		if err := number(2, 0, 99, "year"); err != nil {
+			return value, err
+		}
+		f.isoYear = 1900 + n
+		if n < 69 {
+			f.isoYear += 100
+		}
+		f.has['G'] = true
+	case 'G':
+		if err := number(4, 0, 9999, "year"); err != nil {
+			return value, err
+		}
+		f.isoYear = n
+	case 'H':
+		if err := number(2, 0, 23, "hour"); err != nil {
+			return value, err
+		}
+		f.hour = n
+	case 'I':
+		if err := number(2, 1, 12, "hour"); err != nil {
+			return value, err
+		}
+		f.hour = n
+	case 'j':
+		if err := number(3, 1, 366, "day-of-year"); err != nil {
+			return value, err
+		}
+		f.yday = n
+	case 'm':
+		if err := number(2, 1, 12, "month"); err != nil {
+			return value, err
+		}
+		f.month = n
+	case 'M':
+		if err := number(2, 0, 59, "minute"); err != nil {
+			return value, err
+		}
+		f.min = n
+	case 'n', 't':
+		rest = strings.TrimLeft(value, " \t\n\r\v\f")
+	case 'p':
+		switch {
+		case len(value) >= 2 && strings.EqualFold(value[:2], "AM"):
+			f.pm = 1
+		case len(value) >= 2 && strings.EqualFold(value[:2], "PM"):
+			f.pm = 2
+		default:
+			return value, errBadValue
+		}
+		rest = value[2:]
+	case 's':
+		i := 0
+		if i < len(value) && value[i] == '-' {
+			i++
+		}
+		for i < len(value) && isDigit(value[i]) {
+			i++
+		}
+		unix, err := strconv.ParseInt(value[:i], 10, 64)
+		if err != nil {
+			return value, errBadValue
+		}
+		f.unix, rest = unix, value[i:]
+	case 'S':
+		if err := number(2, 0, 59, "second"); err != nil {
+			return value, err
+		}
+		f.sec = n
+	case 'u':
+		if err := number(1, 1, 7, "weekday"); err != nil {
+			return value, err
+		}
+		f.weekday = n
+	case 'U', 'W':
+		if err := number(2, 0, 53, "week"); err != nil {
+			return value, err
+		}
+		f.weekOfYear, f.weekOfYearSpec = n, spec
+	case 'V':
+		if err := number(2, 1, 53, "week"); err != nil {
+			return value, err
+		}
+		f.isoWeek = n
+	case 'w':
+		if err := number(1, 0, 6, "weekday"); err != nil {
+			return value, err
+		}
+		f.weekday = (n+6)%7 + 1
+	case 'y':
+		if err := number(2, 0, 99, "year"); err != nil {
+			return value, err
+		}
+		f.yearOfCentury = n
+	case 'Y':
+		if err := number(4, 0, 9999, "year"); err != nil {
+			return value, err
+		}
+		f.year = n
+	case 'z':
+		offset, r, ok := parseStrptimeOffset(value)
+		if !ok {
+			return value, errBadValue
+		}
+		f.offset, rest = offset, r
+	case 'Z':
+		i := 0
+		for i < len(value) && (('A' <= value[i] && value[i] <= 'Z') || ('a' <= value[i] && value[i] <= 'z')) {
+			i++
+		}
+		if i == 0 {
+			return value, errBadValue
+		}
+		switch strings.ToUpper(value[:i]) {
+		case "UTC", "GMT", "Z":
+			f.offset = 0
+		default:
+			delete(f.has, 'Z') // the offset is unknown.
+		}
+		rest = value[i:]
+	case '%':
+		if value == "" || value[0] != '%' {
+			return value, errBadValue
+		}
+		rest = value[1:]
+	default:
+		return value, errBadValue
+	}
+	return rest, nil
+}
+
+// parseStrptimeNumber parses a decimal number of up to width digits.
+func parseStrptimeNumber(value string, width int) (int, string, bool) {
+	i := 0
+	for i < len(value) && i < width && isDigit(value[i]) {
+		i++
+	}
+	if i == 0 {
+		return 0, value, false
+	}
+	n, _ := strconv.Atoi(value[:i])
+	return n, value[i:], true
+}
+
+// parseStrptimeOffset parses the UTC offset in the form of "Z", "+hh", "+hhmm" or "+hh:mm".
+func parseStrptimeOffset(value string) (int, string, bool) {
+	if value != "" && (value[0] == 'Z' || value[0] == 'z') {
+		return 0, value[1:], true
+	}
+	if len(value) < 3 || (value[0] != '+' && value[0] != '-') || !isDigit(value[1]) || !isDigit(value[2]) {
+		return 0, value, false
+	}
+	sign := 1
+	if value[0] == '-' {
+		sign = -1
+	}
+	hour := int(value[1]-'0')*10 + int(value[2]-'0')
+	rest := value[3:]
+	min := 0
+	if len(rest) > 0 && rest[0] == ':' {
+		rest = rest[1:]
+		if len(rest) < 2 || !isDigit(rest[0]) || !isDigit(rest[1]) {
+			return 0, value, false
+		}
+	}
+	if len(rest) >= 2 && isDigit(rest[0]) && isDigit(rest[1]) {
+		min = int(rest[0]-'0')*10 + int(rest[1]-'0')
+		rest = rest[2:]
+	}
+	if hour > 23 || min > 59 {
+		return 0, value, false
+	}
+	return sign * (hour*3600 + min*60), rest, true
+}
+
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
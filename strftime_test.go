@@ -0,0 +1,341 @@
+package synchro_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTime_Strftime() {
+	t := synchro.New[tz.AsiaTokyo](2024, 1, 5, 9, 4, 5, 0)
+	fmt.Println(t.Strftime("%Y-%m-%d %H:%M:%S %z"))
+	fmt.Println(t.Strftime("%a, %d %b %Y %I:%M %p (%Z)"))
+	fmt.Println(t.Strftime("%G-W%V-%u, day %j"))
+	// Output:
+	// 2024-01-05 09:04:05 +0900
+	// Fri, 05 Jan 2024 09:04 AM (JST)
+	// 2024-W01-5, day 005
+}
+
+func ExampleStrptime() {
+	t, err := synchro.Strptime[tz.AsiaTokyo]("%Y-%m-%d %H:%M:%S", "2024-01-05 09:04:05")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(t)
+
+	// The time with UTC offset is converted to the timezone.
+	t, err = synchro.Strptime[tz.AsiaTokyo]("%Y-%m-%dT%H:%M:%S%:z", "2024-01-05T09:04:05+01:00")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(t)
+	// Output:
+	// 2024-01-05 09:04:05 +0900 JST
+	// 2024-01-05 17:04:05 +0900 JST
+}
+
+func TestTime_Strftime(t *testing.T) {
+	tm := synchro.New[tz.AmericaNew_York](2021, 1, 3, 21, 7, 9, 123456789) // Sunday
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "%a %A %b %B %h", want: "Sun Sunday Jan January Jan"},
+		{pattern: "%c", want: "Sun Jan  3 21:07:09 2021"},
+		{pattern: "%C %y %Y", want: "20 21 2021"},
+		{pattern: "%d %e %j", want: "03  3 003"},
+		{pattern: "%D %F %x", want: "01/03/21 2021-01-03 01/03/21"},
+		{pattern: "%H %I %M %S %f %p", want: "21 09 07 09 123456 PM"},
+		{pattern: "%r|%R|%T|%X", want: "09:07:09 PM|21:07|21:07:09|21:07:09"},
+		{pattern: "%g %G %V %u", want: "20 2020 53 7"},
+		{pattern: "%U %W %w", want: "01 00 0"},
+		{pattern: "%s", want: "1609726029"},
+		{pattern: "%z %:z %Z", want: "-0500 -05:00 EST"},
+		{pattern: "%n%t%%", want: "\n\t%"},
+		{pattern: "%Q %", want: "%Q %"},
+		{pattern: "no directives", want: "no directives"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := tm.Strftime(tt.pattern); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_StrftimeWeekOfYear(t *testing.T) {
+	tests := []struct {
+		date string
+		want string // %U %W %V
+	}{
+		{date: "2024-01-01", want: "00 01 01"}, // Monday
+		{date: "2024-01-07", want: "01 01 01"}, // Sunday
+		{date: "2024-12-30", want: "52 53 01"},
+		{date: "2023-01-01", want: "01 00 52"}, // Sunday
+		{date: "2020-12-31", want: "52 52 53"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			d, err := synchro.ParseDate[tz.UTC](tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.StartOfDay().Strftime("%U %W %V"); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    synchro.Time[tz.AsiaTokyo]
+	}{
+		{
+			pattern: "%Y-%m-%d %H:%M:%S",
+			value:   "2024-01-05 09:04:05",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 9, 4, 5, 0),
+		},
+		{
+			pattern: "%Y-%m-%d %H:%M:%S %z",
+			value:   "2024-01-05 09:04:05 -0130",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 19, 34, 5, 0),
+		},
+		{
+			pattern: "%Y-%m-%dT%H:%M:%S%z",
+			value:   "2024-01-05T09:04:05Z",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 18, 4, 5, 0),
+		},
+		{
+			pattern: "%d/%b/%Y:%H:%M:%S %z",
+			value:   "10/Oct/2000:13:55:36 -0700",
+			want:    synchro.New[tz.AsiaTokyo](2000, 10, 11, 5, 55, 36, 0),
+		},
+		{
+			pattern: "%A, %B %e, %Y %I:%M %p",
+			value:   "friday,   JANUARY 5, 2024 12:30 am",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 0, 30, 0, 0),
+		},
+		{
+			pattern: "%I%p",
+			value:   "12PM",
+			want:    synchro.New[tz.AsiaTokyo](0, 1, 1, 12, 0, 0, 0),
+		},
+		{
+			pattern: "%c",
+			value:   "Sun Jan  3 21:07:09 2021",
+			want:    synchro.New[tz.AsiaTokyo](2021, 1, 3, 21, 7, 9, 0),
+		},
+		{
+			pattern: "%D %T",
+			value:   "01/03/69 21:07:09",
+			want:    synchro.New[tz.AsiaTokyo](1969, 1, 3, 21, 7, 9, 0),
+		},
+		{
+			pattern: "%y-%m-%d",
+			value:   "68-12-31",
+			want:    synchro.New[tz.AsiaTokyo](2068, 12, 31, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%C%y",
+			value:   "1812",
+			want:    synchro.New[tz.AsiaTokyo](1812, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%Y %j",
+			value:   "2024 366",
+			want:    synchro.New[tz.AsiaTokyo](2024, 12, 31, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%G-W%V-%u",
+			value:   "2020-W53-7",
+			want:    synchro.New[tz.AsiaTokyo](2021, 1, 3, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%G-W%V",
+			value:   "2025-W01",
+			want:    synchro.New[tz.AsiaTokyo](2024, 12, 30, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%Y %U %a",
+			value:   "2023 01 Sun",
+			want:    synchro.New[tz.AsiaTokyo](2023, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%Y %U %a",
+			value:   "2024 00 Sat",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 6, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%Y %U %w",
+			value:   "2024 01 3",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 10, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%Y %W %u",
+			value:   "2024 01 1",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 1, 0, 0, 0, 0),
+		},
+		{
+			pattern: "%s",
+			value:   "1609726029",
+			want:    synchro.New[tz.AsiaTokyo](2021, 1, 4, 11, 7, 9, 0),
+		},
+		{
+			pattern: "%H:%M:%S.%f",
+			value:   "01:02:03.12",
+			want:    synchro.New[tz.AsiaTokyo](0, 1, 1, 1, 2, 3, 120000000),
+		},
+		{
+			pattern: "%Y-%m-%d %H:%M %Z",
+			value:   "2024-01-05 09:04 UTC",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 18, 4, 0, 0),
+		},
+		{
+			pattern: "%Y-%m-%d %H:%M %Z",
+			value:   "2024-01-05 09:04 JST",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 9, 4, 0, 0),
+		},
+		{
+			pattern: "%Y%m%d%n%%",
+			value:   "20240105%",
+			want:    synchro.New[tz.AsiaTokyo](2024, 1, 5, 0, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.value, func(t *testing.T) {
+			got, err := synchro.Strptime[tz.AsiaTokyo](tt.pattern, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want.Equal(got) {
+				t.Errorf("want %v but got %v", tt.want, got)
+			}
+			if got.Location().String() != "Asia/Tokyo" {
+				t.Errorf("want the time in Asia/Tokyo but got %v", got)
+			}
+		})
+	}
+}
+
+func TestStrptime_RoundTrip(t *testing.T) {
+	want := synchro.New[tz.EuropeLondon](2024, 7, 14, 23, 59, 58, 123456000)
+	for _, pattern := range []string{
+		"%Y-%m-%d %H:%M:%S.%f %z",
+		"%a %d %B %Y %I:%M:%S.%f %p %:z",
+		"%G-W%V-%u %T.%f",
+		"%Y-%j %T.%f",
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			got, err := synchro.Strptime[tz.EuropeLondon](pattern, want.Strftime(pattern))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !want.Equal(got) {
+				t.Errorf("want %v but got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestStrptime_Error(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    string
+	}{
+		{
+			pattern: "%Y-%m-%d",
+			value:   "2024/01/05",
+			want:    `parsing time "2024/01/05" as "%Y-%m-%d": cannot parse "/01/05" as "-%m-%d"`,
+		},
+		{
+			pattern: "%Y-%m-%d",
+			value:   "2024-13-05",
+			want:    `parsing time "2024-13-05": month out of range`,
+		},
+		{
+			pattern: "%Y-%m-%d",
+			value:   "2023-02-29",
+			want:    `parsing time "2023-02-29": day out of range`,
+		},
+		{
+			pattern: "%Y-%m-%d",
+			value:   "2024-01-05 10:00",
+			want:    `parsing time "2024-01-05 10:00": extra text: " 10:00"`,
+		},
+		{
+			pattern: "%Y %j",
+			value:   "2023 366",
+			want:    `parsing time "2023 366": day-of-year out of range`,
+		},
+		{
+			pattern: "%G-W%V",
+			value:   "2024-W53",
+			want:    `parsing time "2024-W53": week out of range`,
+		},
+		{
+			pattern: "%Y-W%V-%u",
+			value:   "2024-W05-1",
+			want:    `parsing time "2024-W05-1": ISO 8601 week without week-based year`,
+		},
+		{
+			pattern: "%G %m",
+			value:   "2024 05",
+			want:    `parsing time "2024 05": ISO 8601 week without week-based year`,
+		},
+		{
+			pattern: "%Y %W",
+			value:   "2024 01",
+			want:    `parsing time "2024 01": week of the year without weekday`,
+		},
+		{
+			pattern: "%Y-%m-%d %U",
+			value:   "2024-01-05 00",
+			want:    `parsing time "2024-01-05 00": week of the year without weekday`,
+		},
+		{
+			pattern: "%H:%M %p",
+			value:   "10:00 XM",
+			want:    `parsing time "10:00 XM" as "%H:%M %p": cannot parse "XM" as "%p"`,
+		},
+		{
+			pattern: "%T",
+			value:   "10:00",
+			want:    `parsing time "10:00" as "%T": cannot parse "10:00" as "%T"`,
+		},
+		{
+			pattern: "%:z",
+			value:   "+9",
+			want:    `parsing time "+9" as "%:z": cannot parse "+9" as "%:z"`,
+		},
+		{
+			pattern: "%Q",
+			value:   "Q",
+			want:    `parsing time "Q" as "%Q": cannot parse "Q" as "%Q"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.value, func(t *testing.T) {
+			_, err := synchro.Strptime[tz.UTC](tt.pattern, tt.value)
+			if err == nil {
+				t.Fatal("want error")
+			}
+			var perr *time.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("want *time.ParseError but got %T", err)
+			}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -109,9 +109,14 @@ func Parse[T TimeZone](layout, value string) (Time[T], error) {
 //	20070301T130045Z             2007-03-01T13:00:45Z
 //	20070301T130045+0100         2007-03-01T13:00:45+01:00
 //	... and other combinations
-func ParseISO[T TimeZone](value string) (Time[T], error) {
+//
+// The options are passed to iso8601.ParseDateTime. For example, use
+// iso8601.WithProfile(iso8601.RFC3339Profile) to accept only RFC 3339
+// date-times, or iso8601.WithProfile(iso8601.LenientProfile) to also accept
+// such as "2007-03-01 13:00:45 UTC".
+func ParseISO[T TimeZone](value string, opts ...iso8601.ParseDateTimeOptions) (Time[T], error) {
 	var tz T
-	tm, err := iso8601.ParseDateTime[string](value, iso8601.WithInLocation(tz.Location()))
+	tm, err := iso8601.ParseDateTimeInLocation(value, tz.Location(), opts...)
 	if err != nil {
 		return Time[T]{}, err
 	}
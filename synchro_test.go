@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
 	"github.com/Code-Hex/synchro/tz"
 )
 
@@ -203,4 +204,67 @@ func TestParseISO(t *testing.T) {
 		}
 
 	})
+
+	t.Run("profile", func(t *testing.T) {
+		want := synchro.New[tz.UTC](2017, 4, 24, 9, 41, 34, 0)
+		got, err := synchro.ParseISO[tz.UTC]("2017-04-24 09:41:34 UTC", iso8601.WithProfile(iso8601.LenientProfile))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !want.Equal(got) {
+			t.Fatalf("want %q but got %q", want, got)
+		}
+		if _, err := synchro.ParseISO[tz.UTC]("2017-04-24T09:41Z", iso8601.WithProfile(iso8601.RFC3339Profile)); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		for name, parse := range map[string]func() error{
+			"UTC": func() error {
+				_, err := synchro.ParseISO[tz.UTC]("2024-01-01T10:00:00Z")
+				return err
+			},
+			"Asia/Tokyo": func() error {
+				_, err := synchro.ParseISO[tz.AsiaTokyo]("2024-01-01T10:00:00")
+				return err
+			},
+			"profile": func() error {
+				_, err := synchro.ParseISO[tz.UTC]("2024-01-01T10:00:00Z", iso8601.WithProfile(iso8601.RFC3339Profile))
+				return err
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				if err := parse(); err != nil {
+					t.Fatal(err)
+				}
+				if allocs := testing.AllocsPerRun(100, func() { _ = parse() }); allocs != 0 {
+					t.Errorf("want no allocations but got %v", allocs)
+				}
+			})
+		}
+	})
+}
+
+func BenchmarkParseISO(b *testing.B) {
+	const value = "2024-03-01T12:30:45.123456789+09:00"
+	for name, opts := range map[string][]iso8601.ParseDateTimeOptions{
+		"default":  nil,
+		"RFC 3339": {iso8601.WithProfile(iso8601.RFC3339Profile)},
+	} {
+		b.Run(name, func(b *testing.B) {
+			allocs := testing.AllocsPerRun(100, func() {
+				_, _ = synchro.ParseISO[tz.AsiaTokyo](value, opts...)
+			})
+			if allocs != 0 {
+				b.Fatalf("want no allocations but got %v", allocs)
+			}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := synchro.ParseISO[tz.AsiaTokyo](value, opts...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
 }
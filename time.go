@@ -3,6 +3,8 @@ package synchro
 import (
 	"math"
 	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
 )
 
 type empty[T TimeZone] struct{}
@@ -107,3 +109,17 @@ func (t Time[T]) DiffInCalendarDays(u Time[T]) int {
 	u1 := u.Truncate(day)
 	return int(math.Ceil(float64(t1.Sub(u1)) / float64(day)))
 }
+
+// FormatISO returns the ISO 8601 representation of t. By default, it is the
+// extended format such as "2012-12-24T12:30:45.5+09:00", which can be parsed
+// by ParseISO into the same time. Use iso8601.FormatOptions to change the
+// representation.
+func (t Time[T]) FormatISO(opts ...iso8601.FormatOptions) string {
+	return iso8601.Format(t.tm, opts...)
+}
+
+// AppendFormatISO is like FormatISO but appends the representation to b
+// and returns the extended buffer.
+func (t Time[T]) AppendFormatISO(b []byte, opts ...iso8601.FormatOptions) []byte {
+	return iso8601.AppendFormat(b, t.tm, opts...)
+}
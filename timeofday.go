@@ -0,0 +1,252 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// TimeOfDay represents a wall-clock time within a day, such as opening hours
+// or the schedule of daily jobs. It has no date and no timezone.
+//
+// TimeOfDay is in the range [00:00:00, 24:00:00]. The time '24:00:00'
+// represents midnight at the end of the day, as allowed by ISO 8601.
+//
+// Use On or OnDateOf to combine a TimeOfDay with a day in the timezone T.
+type TimeOfDay struct {
+	// d is the elapsed time since midnight.
+	d time.Duration
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*TimeOfDay)(nil)
+
+const oneDay = 24 * time.Hour
+
+// NewTimeOfDay returns the TimeOfDay corresponding to hh:mm:ss + nsec nanoseconds.
+//
+// The hour, min, sec, and nsec values may be outside their usual ranges
+// and will be normalized by wrapping around midnight. For example,
+// 25:00 converts to 01:00. Use TimeOfDayFromISO to get '24:00:00'.
+func NewTimeOfDay(hour int, min int, sec int, nsec int) TimeOfDay {
+	d := time.Duration(hour)*time.Hour +
+		time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second +
+		time.Duration(nsec)
+	return TimeOfDay{d: wrapDay(d)}
+}
+
+// TimeOfDayFromISO returns the TimeOfDay corresponding to the given iso8601.Time.
+// An error is returned if t is not valid.
+func TimeOfDayFromISO(t iso8601.Time) (TimeOfDay, error) {
+	if err := t.Validate(); err != nil {
+		return TimeOfDay{}, err
+	}
+	if t.Hour == 24 {
+		return TimeOfDay{d: oneDay}, nil
+	}
+	return NewTimeOfDay(t.Hour, t.Minute, t.Second, t.Nanosecond), nil
+}
+
+// ParseTimeOfDay parses an ISO8601-compliant time string and returns
+// the TimeOfDay it represents. Supported formats include:
+//
+//	Basic              Extended
+//	12                 N/A
+//	1230               12:30
+//	123045             12:30:45
+//	123045.123456789   12:30:45.123456789
+//	240000             24:00:00
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	t, err := iso8601.ParseTime(value)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayFromISO(t)
+}
+
+// TimeOfDay returns the wall-clock time within the day of t in the timezone T.
+func (t Time[T]) TimeOfDay() TimeOfDay {
+	return NewTimeOfDay(t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// On returns the Time[T] at the time of day tod on d.
+//
+// If tod does not exist or is ambiguous on d because of a daylight
+// saving time transition, it is resolved with DisambiguateCompatible.
+// '24:00:00' represents the start of the next day.
+func On[T TimeZone](tod TimeOfDay, d Date[T]) Time[T] {
+	year, month, day := d.Date()
+	hour, min, sec := tod.Clock()
+	l := NewLocalDateTime(year, month, day, hour, min, sec, tod.Nanosecond())
+	if tod.IsEndOfDay() {
+		l = NewLocalDateTime(year, month, day+1, 0, 0, 0, 0)
+	}
+	t, _ := FromLocalDateTime[T](l, DisambiguateCompatible) // never fails
+	return t
+}
+
+// OnDateOf returns the Time[T] at the time of day tod on the same day as t.
+// It is a shorthand for On(tod, DateOf(t)).
+func OnDateOf[T TimeZone](tod TimeOfDay, t Time[T]) Time[T] {
+	return On(tod, DateOf(t))
+}
+
+// At returns the Time[T] at the time of day tod on d.
+// It is the same as On(tod, d).
+func (d Date[T]) At(tod TimeOfDay) Time[T] {
+	return On(tod, d)
+}
+
+// At returns the Time[T] at the time of day tod on the same day as t.
+// It is the same as OnDateOf(tod, t).
+func (t Time[T]) At(tod TimeOfDay) Time[T] {
+	return OnDateOf(tod, t)
+}
+
+func wrapDay(d time.Duration) time.Duration {
+	d %= oneDay
+	if d < 0 {
+		d += oneDay
+	}
+	return d
+}
+
+// Hour returns the hour of t, in the range [0, 24].
+func (t TimeOfDay) Hour() int { return int(t.d / time.Hour) }
+
+// Minute returns the minute offset within the hour of t, in the range [0, 59].
+func (t TimeOfDay) Minute() int { return int(t.d % time.Hour / time.Minute) }
+
+// Second returns the second offset within the minute of t, in the range [0, 59].
+func (t TimeOfDay) Second() int { return int(t.d % time.Minute / time.Second) }
+
+// Nanosecond returns the nanosecond offset within the second of t,
+// in the range [0, 999999999].
+func (t TimeOfDay) Nanosecond() int { return int(t.d % time.Second) }
+
+// Clock returns the hour, minute, and second of t.
+func (t TimeOfDay) Clock() (hour, min, sec int) {
+	return t.Hour(), t.Minute(), t.Second()
+}
+
+// IsEndOfDay reports whether t is '24:00:00'.
+func (t TimeOfDay) IsEndOfDay() bool { return t.d == oneDay }
+
+// SinceMidnight returns the elapsed time since midnight of t.
+func (t TimeOfDay) SinceMidnight() time.Duration { return t.d }
+
+// Add returns the time of day t+d, wrapping around midnight.
+// For example, 23:00 + 2h is 01:00.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return TimeOfDay{d: wrapDay(t.d + d)}
+}
+
+// Sub returns the duration t-u. The result is in the range [-24h, 24h].
+func (t TimeOfDay) Sub(u TimeOfDay) time.Duration {
+	return t.d - u.d
+}
+
+// After reports whether t is after u.
+func (t TimeOfDay) After(u TimeOfDay) bool { return t.d > u.d }
+
+// Before reports whether t is before u.
+func (t TimeOfDay) Before(u TimeOfDay) bool { return t.d < u.d }
+
+// Equal reports whether t and u represent the same time of day.
+func (t TimeOfDay) Equal(u TimeOfDay) bool { return t.d == u.d }
+
+// Compare compares t with u. If t is before u, it returns -1;
+// if t is after u, it returns +1; if they're the same, it returns 0.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch {
+	case t.d < u.d:
+		return -1
+	case t.d > u.d:
+		return 1
+	}
+	return 0
+}
+
+// ISOTime returns t as iso8601.Time.
+func (t TimeOfDay) ISOTime() iso8601.Time {
+	return iso8601.Time{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+// String returns the ISO8601 string representation of the format "hh:mm:ss"
+// with sub-second precision if present. For example: "12:30:45.123".
+func (t TimeOfDay) String() string {
+	b := make([]byte, 0, len("15:04:05.999999999"))
+	return string(t.appendString(b))
+}
+
+func (t TimeOfDay) appendString(b []byte) []byte {
+	appendInt2 := func(b []byte, v int) []byte {
+		return append(b, byte('0'+v/10), byte('0'+v%10))
+	}
+	b = appendInt2(b, t.Hour())
+	b = append(b, ':')
+	b = appendInt2(b, t.Minute())
+	b = append(b, ':')
+	b = appendInt2(b, t.Second())
+	if nsec := t.Nanosecond(); nsec != 0 {
+		frac := strconv.Itoa(nsec + 1e9)[1:] // zero-padded 9 digits
+		for frac[len(frac)-1] == '0' {
+			frac = frac[:len(frac)-1]
+		}
+		b = append(b, '.')
+		b = append(b, frac...)
+	}
+	return b
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the same as String.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return t.appendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value must be in a format supported by ParseTimeOfDay.
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	parsed, err := ParseTimeOfDay(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is a quoted string which is the same as String.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be a quoted string in a format supported by ParseTimeOfDay.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
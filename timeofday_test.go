@@ -0,0 +1,202 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleTimeOfDay_Add() {
+	closing := synchro.NewTimeOfDay(23, 0, 0, 0)
+	fmt.Println(closing.Add(2 * time.Hour))
+	fmt.Println(closing.Add(-24 * time.Hour))
+	// Output:
+	// 01:00:00
+	// 23:00:00
+}
+
+func ExampleOn() {
+	opening := synchro.NewTimeOfDay(9, 30, 0, 0)
+	d := synchro.NewDate[tz.AsiaTokyo](2023, 9, 2)
+	fmt.Println(synchro.On(opening, d))
+	// Output:
+	// 2023-09-02 09:30:00 +0900 JST
+}
+
+func ExampleDate_At() {
+	opening := synchro.NewTimeOfDay(9, 30, 0, 0)
+	d := synchro.NewDate[tz.AsiaTokyo](2023, 9, 2)
+	fmt.Println(d.At(opening))
+	// Output:
+	// 2023-09-02 09:30:00 +0900 JST
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "12:30:45", want: "12:30:45"},
+		{value: "123045", want: "12:30:45"},
+		{value: "12:30", want: "12:30:00"},
+		{value: "12:30:45.120", want: "12:30:45.12"},
+		{value: "24:00:00", want: "24:00:00"},
+		{value: "24:00:01", wantErr: true},
+		{value: "12:60", wantErr: true},
+		{value: "12:30Z", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseTimeOfDay(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	tod := synchro.NewTimeOfDay(25, 61, 0, 5)
+	if hour, min, sec := tod.Clock(); hour != 2 || min != 1 || sec != 0 {
+		t.Errorf("want 02:01:00 but got %02d:%02d:%02d", hour, min, sec)
+	}
+	if tod.Nanosecond() != 5 {
+		t.Errorf("want 5 but got %d", tod.Nanosecond())
+	}
+	if want := (iso8601.Time{Hour: 2, Minute: 1, Nanosecond: 5}); tod.ISOTime() != want {
+		t.Errorf("want %v but got %v", want, tod.ISOTime())
+	}
+
+	end, err := synchro.TimeOfDayFromISO(iso8601.Time{Hour: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !end.IsEndOfDay() || end.SinceMidnight() != 24*time.Hour {
+		t.Errorf("want end of day but got %s", end)
+	}
+	if !end.After(tod) || !tod.Before(end) || end.Compare(tod) != 1 {
+		t.Errorf("%s should be after %s", end, tod)
+	}
+	if got := end.Add(0); !got.Equal(synchro.NewTimeOfDay(0, 0, 0, 0)) {
+		t.Errorf("want 00:00:00 but got %s", got)
+	}
+	if got := end.Sub(tod); got != 22*time.Hour-time.Minute-5 {
+		t.Errorf("unexpected %v", got)
+	}
+}
+
+func TestTime_TimeOfDay(t *testing.T) {
+	tm := synchro.New[tz.AsiaTokyo](2023, 9, 2, 23, 4, 5, 6)
+	want := synchro.NewTimeOfDay(23, 4, 5, 6)
+	if got := tm.TimeOfDay(); !got.Equal(want) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if got := tm.At(want); !got.Equal(tm) {
+		t.Errorf("want %s but got %s", tm, got)
+	}
+	if got := synchro.OnDateOf(want, tm); !got.Equal(tm) {
+		t.Errorf("want %s but got %s", tm, got)
+	}
+}
+
+func TestDate_At(t *testing.T) {
+	d := synchro.NewDate[tz.AmericaNew_York](2024, 3, 10)
+	tests := []struct {
+		tod  synchro.TimeOfDay
+		want synchro.Time[tz.AmericaNew_York]
+	}{
+		{
+			tod:  synchro.NewTimeOfDay(1, 0, 0, 0),
+			want: synchro.New[tz.AmericaNew_York](2024, 3, 10, 1, 0, 0, 0),
+		},
+		{
+			// does not exist
+			tod:  synchro.NewTimeOfDay(2, 30, 0, 0),
+			want: synchro.New[tz.AmericaNew_York](2024, 3, 10, 3, 30, 0, 0),
+		},
+		{
+			tod:  mustParseTimeOfDay(t, "24:00"),
+			want: synchro.New[tz.AmericaNew_York](2024, 3, 11, 0, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tod.String(), func(t *testing.T) {
+			if got := d.At(tt.tod); !tt.want.Equal(got) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+			if got := synchro.On(tt.tod, d); !tt.want.Equal(got) {
+				t.Errorf("On: want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func mustParseTimeOfDay(t *testing.T, s string) synchro.TimeOfDay {
+	t.Helper()
+	tod, err := synchro.ParseTimeOfDay(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tod
+}
+
+func TestTimeOfDay_JSON(t *testing.T) {
+	want := synchro.NewTimeOfDay(9, 0, 0, 0)
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"09:00:00"` {
+		t.Errorf("unexpected %s", b)
+	}
+	var got synchro.TimeOfDay
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	want := synchro.NewTimeOfDay(14, 3, 54, 123456000)
+	for _, src := range []any{
+		"14:03:54.123456",
+		[]byte("14:03:54.123456"),
+		time.Date(2000, 1, 1, 14, 3, 54, 123456000, time.UTC),
+	} {
+		var got synchro.TimeOfDay
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("Scan(%v) returned an unexpected error: %v", src, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("Scan(%v) = %v, want %v", src, got, want)
+		}
+	}
+	var got synchro.TimeOfDay
+	if err := got.Scan(1.5); err == nil {
+		t.Errorf("expected error")
+	}
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "14:03:54.123456" {
+		t.Errorf("unexpected value %q", v)
+	}
+}
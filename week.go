@@ -0,0 +1,197 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// Week represents an ISO 8601 week in the timezone T, such as 2024-W07.
+// ISO weeks start on Monday, and week 1 of a year is the week which
+// contains the first Thursday of that year.
+//
+// Week values can be compared with == and used as map keys.
+// The zero value of Week represents the week 0001-W01, which starts on
+// January 1, year 1.
+type Week[T TimeZone] struct {
+	// tm is always Monday of the week at midnight in UTC.
+	tm time.Time
+	_  empty[T]
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*Week[tz.UTC])(nil)
+
+// NewWeek returns the Week corresponding to the given ISO week-numbering year and week.
+//
+// The week value may be outside its usual range and will be normalized
+// during the conversion. For example, week 53 of 2023 converts to 2024-W01
+// because 2023 has 52 weeks.
+func NewWeek[T TimeZone](year, week int) Week[T] {
+	// January 4 is always in week 1.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(week-1)*7)
+	return Week[T]{tm: monday}
+}
+
+// Week returns the ISO 8601 week in which t occurs.
+func (t Time[T]) Week() Week[T] {
+	return NewWeek[T](t.ISOWeek())
+}
+
+// WeekFromISO returns the Week corresponding to the given ISO 8601 week representation.
+func WeekFromISO[T TimeZone](yw iso8601.YearWeek) Week[T] {
+	return NewWeek[T](yw.Year, yw.Week)
+}
+
+// ParseWeek parses an ISO8601-compliant week string and returns the Week
+// it represents. Supported formats include:
+//
+//	Basic           Extended
+//	2024W07         2024-W07
+func ParseWeek[T TimeZone](value string) (Week[T], error) {
+	p, err := iso8601.ParsePartialDate(value)
+	if err != nil {
+		return Week[T]{}, err
+	}
+	yw, ok := p.(iso8601.YearWeek)
+	if !ok {
+		return Week[T]{}, &iso8601.UnexpectedTokenError{
+			Value:    value,
+			Token:    value,
+			Expected: "YYYY-Www",
+			Length:   len(value),
+			Kind:     iso8601.KindUnexpectedToken,
+		}
+	}
+	return WeekFromISO[T](yw), nil
+}
+
+// Year returns the ISO week-numbering year of w. It may differ from the
+// calendar year of the first or the last days of w.
+func (w Week[T]) Year() int {
+	year, _ := w.tm.ISOWeek()
+	return year
+}
+
+// Number returns the number of week in the range [1,53].
+func (w Week[T]) Number() int {
+	_, week := w.tm.ISOWeek()
+	return week
+}
+
+// WeeksInYear returns the number of ISO weeks, 52 or 53, in the
+// ISO week-numbering year of w.
+func (w Week[T]) WeeksInYear() int {
+	// December 28 is always in the last week of the year.
+	_, week := time.Date(w.Year(), time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// IsZero reports whether w represents the zero value, 0001-W01.
+func (w Week[T]) IsZero() bool { return w.tm.IsZero() }
+
+// Date returns the date of the given weekday in w.
+// Because ISO weeks start on Monday, time.Sunday is the last date of w.
+func (w Week[T]) Date(weekday time.Weekday) Date[T] {
+	return Date[T]{tm: w.tm.AddDate(0, 0, (int(weekday)+6)%7)}
+}
+
+// Start returns start time in the week, which is Monday.
+func (w Week[T]) Start() Time[T] { return w.Date(time.Monday).StartOfDay() }
+
+// End returns end time in the week, which is Sunday.
+func (w Week[T]) End() Time[T] { return w.Date(time.Sunday).EndOfDay() }
+
+// Days returns an iterator which yields the start of each day in the week.
+func (w Week[T]) Days() *Iterator[T] {
+	return Range(w.Start(), w.End(), iso8601.Duration{Day: 1})
+}
+
+// Contains reports whether t occurs in w.
+func (w Week[T]) Contains(t Time[T]) bool {
+	return t.Week() == w
+}
+
+// Add returns the week n weeks after w. n may be negative.
+func (w Week[T]) Add(n int) Week[T] {
+	return Week[T]{tm: w.tm.AddDate(0, 0, n*7)}
+}
+
+// Next returns the week after w.
+func (w Week[T]) Next() Week[T] { return w.Add(1) }
+
+// Prev returns the week before w.
+func (w Week[T]) Prev() Week[T] { return w.Add(-1) }
+
+// Sub returns the number of weeks w-u.
+func (w Week[T]) Sub(u Week[T]) int {
+	const secondsPerWeek = 7 * 24 * 60 * 60
+	return int((w.tm.Unix() - u.tm.Unix()) / secondsPerWeek)
+}
+
+// After reports whether w is after u.
+func (w Week[T]) After(u Week[T]) bool { return w.tm.After(u.tm) }
+
+// Before reports whether w is before u.
+func (w Week[T]) Before(u Week[T]) bool { return w.tm.Before(u.tm) }
+
+// Equal reports whether w and u represent the same week.
+func (w Week[T]) Equal(u Week[T]) bool { return w.tm.Equal(u.tm) }
+
+// Compare compares w with u. If w is before u, it returns -1;
+// if w is after u, it returns +1; if they're the same, it returns 0.
+func (w Week[T]) Compare(u Week[T]) int { return w.tm.Compare(u.tm) }
+
+// String returns the ISO8601 string representation of the format "YYYY-Www".
+// For example: "2024-W07".
+func (w Week[T]) String() string {
+	year, week := w.tm.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The week is formatted as "YYYY-Www".
+func (w Week[T]) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The week must be in a format supported by ParseWeek.
+func (w *Week[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseWeek[T](string(data))
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The week is a quoted string in the "YYYY-Www" format.
+func (w Week[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The week must be a quoted string in a format supported by ParseWeek.
+func (w *Week[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return w.UnmarshalText([]byte(s))
+}
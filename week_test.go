@@ -0,0 +1,207 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleWeek() {
+	w := synchro.New[tz.AsiaTokyo](2024, 2, 14, 12, 0, 0, 0).Week()
+	fmt.Println(w)
+	fmt.Println(w.Start())
+	fmt.Println(w.End())
+	fmt.Println(w.Prev(), w.Next())
+	// Output:
+	// 2024-W07
+	// 2024-02-12 00:00:00 +0900 JST
+	// 2024-02-18 23:59:59.999999999 +0900 JST
+	// 2024-W06 2024-W08
+}
+
+func TestNewWeek(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         synchro.Week[tz.UTC]
+		want      string
+		wantStart synchro.Date[tz.UTC]
+	}{
+		{
+			name:      "first week starts in the previous year",
+			w:         synchro.NewWeek[tz.UTC](2020, 1),
+			want:      "2020-W01",
+			wantStart: synchro.NewDate[tz.UTC](2019, 12, 30),
+		},
+		{
+			name:      "53rd week",
+			w:         synchro.NewWeek[tz.UTC](2020, 53),
+			want:      "2020-W53",
+			wantStart: synchro.NewDate[tz.UTC](2020, 12, 28),
+		},
+		{
+			name:      "normalized to the next year",
+			w:         synchro.NewWeek[tz.UTC](2023, 53),
+			want:      "2024-W01",
+			wantStart: synchro.NewDate[tz.UTC](2024, 1, 1),
+		},
+		{
+			name:      "normalized to the previous year",
+			w:         synchro.NewWeek[tz.UTC](2024, 0),
+			want:      "2023-W52",
+			wantStart: synchro.NewDate[tz.UTC](2023, 12, 25),
+		},
+		{
+			name:      "from time in the next calendar year",
+			w:         synchro.New[tz.UTC](2021, 1, 3, 12, 0, 0, 0).Week(),
+			want:      "2020-W53",
+			wantStart: synchro.NewDate[tz.UTC](2020, 12, 28),
+		},
+		{
+			name:      "zero value",
+			w:         synchro.Week[tz.UTC]{},
+			want:      "0001-W01",
+			wantStart: synchro.NewDate[tz.UTC](1, 1, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.w.String(); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+			if got := tt.w.Date(time.Monday); got != tt.wantStart {
+				t.Errorf("want start %s but got %s", tt.wantStart, got)
+			}
+			if got := tt.w.Date(time.Sunday); got != tt.wantStart.AddDays(6) {
+				t.Errorf("want end %s but got %s", tt.wantStart.AddDays(6), got)
+			}
+		})
+	}
+	if !(synchro.Week[tz.UTC]{}).Equal(synchro.NewWeek[tz.UTC](1, 1)) {
+		t.Errorf("zero value should be 0001-W01")
+	}
+}
+
+func TestWeek_WeeksInYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{year: 2015, want: 53},
+		{year: 2019, want: 52},
+		{year: 2020, want: 53},
+		{year: 2024, want: 52},
+		{year: 2026, want: 53},
+	}
+	for _, tt := range tests {
+		if got := synchro.NewWeek[tz.UTC](tt.year, 10).WeeksInYear(); got != tt.want {
+			t.Errorf("%d: want %d but got %d", tt.year, tt.want, got)
+		}
+	}
+}
+
+func TestWeek_Contains(t *testing.T) {
+	w := synchro.NewWeek[tz.AmericaNew_York](2024, 10) // includes DST start on 2024-03-10
+	days := w.Days().Take(10)
+	if len(days) != 7 {
+		t.Fatalf("want 7 days but got %d", len(days))
+	}
+	for _, d := range days {
+		if !w.Contains(d) {
+			t.Errorf("want %v in %s", d, w)
+		}
+	}
+	if w.Contains(w.Start().Add(-time.Nanosecond)) || w.Contains(w.End().Add(time.Nanosecond)) {
+		t.Errorf("want boundaries to be excluded")
+	}
+	if got := w.End().Sub(w.Start()); got != 7*24*time.Hour-time.Hour-time.Nanosecond {
+		t.Errorf("unexpected length of week: %v", got)
+	}
+}
+
+func TestWeek_Add(t *testing.T) {
+	w := synchro.NewWeek[tz.UTC](2020, 52)
+	if got := w.Add(2).String(); got != "2021-W01" {
+		t.Errorf("want 2021-W01 but got %s", got)
+	}
+	if got := w.Add(2).Sub(w); got != 2 {
+		t.Errorf("want 2 but got %d", got)
+	}
+	if got := w.Sub(w.Add(5)); got != -5 {
+		t.Errorf("want -5 but got %d", got)
+	}
+	// More than 292 years apart, which time.Duration cannot hold.
+	if got := w.Add(20000).Sub(w); got != 20000 {
+		t.Errorf("want 20000 but got %d", got)
+	}
+	if got := w.Sub(w.Add(20000)); got != -20000 {
+		t.Errorf("want -20000 but got %d", got)
+	}
+	if !w.Next().After(w) || !w.Prev().Before(w) || w.Next().Prev().Compare(w) != 0 {
+		t.Errorf("unexpected comparison")
+	}
+}
+
+func TestParseWeek(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.Week[tz.UTC]
+		wantErr bool
+	}{
+		{value: "2024-W07", want: synchro.NewWeek[tz.UTC](2024, 7)},
+		{value: "2024W07", want: synchro.NewWeek[tz.UTC](2024, 7)},
+		{value: "2020-W53", want: synchro.NewWeek[tz.UTC](2020, 53)},
+		{value: "2024-W53", wantErr: true},
+		{value: "2024-W00", wantErr: true},
+		{value: "2024-W7", wantErr: true},
+		{value: "2024-07", wantErr: true},
+		{value: "24-W07", wantErr: true},
+		{value: "2024-W07-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseWeek[tz.UTC](tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("want error but got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+
+	_, err := synchro.ParseWeek[tz.UTC]("2024-W53")
+	var rangeErr *iso8601.DateLikeRangeError
+	if !errors.As(err, &rangeErr) {
+		t.Errorf("want DateLikeRangeError but got %T", err)
+	}
+}
+
+func TestWeek_JSON(t *testing.T) {
+	w := synchro.NewWeek[tz.UTC](2024, 7)
+	b, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"2024-W07"`; string(b) != want {
+		t.Errorf("want %s but got %s", want, b)
+	}
+	var got synchro.Week[tz.UTC]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != w {
+		t.Errorf("want %s but got %s", w, got)
+	}
+}
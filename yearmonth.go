@@ -0,0 +1,175 @@
+package synchro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// YearMonth represents a calendar month of a year in the timezone T,
+// such as February 2024. It is useful for monthly periods such as invoicing.
+//
+// YearMonth values can be compared with == and used as map keys.
+// The zero value of YearMonth represents January, year 1.
+type YearMonth[T TimeZone] struct {
+	// tm is always the first day of the month at midnight in UTC.
+	tm time.Time
+	_  empty[T]
+}
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*YearMonth[tz.UTC])(nil)
+
+// NewYearMonth returns the YearMonth corresponding to the given year and month.
+//
+// The month value may be outside its usual range and will be normalized
+// during the conversion. For example, the 13th month of 2023 converts to January 2024.
+func NewYearMonth[T TimeZone](year int, month time.Month) YearMonth[T] {
+	return YearMonth[T]{tm: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// YearMonth returns the calendar month in which t occurs.
+func (t Time[T]) YearMonth() YearMonth[T] {
+	return NewYearMonth[T](t.Year(), t.Month())
+}
+
+// YearMonthFromISO returns the YearMonth corresponding to the given ISO 8601
+// calendar month representation.
+func YearMonthFromISO[T TimeZone](ym iso8601.YearMonth) YearMonth[T] {
+	return NewYearMonth[T](ym.Year, ym.Month)
+}
+
+// ParseYearMonth parses a string in the ISO8601-compliant "YYYY-MM" format
+// and returns the YearMonth it represents. For example: "2024-02".
+func ParseYearMonth[T TimeZone](value string) (YearMonth[T], error) {
+	p, err := iso8601.ParsePartialDate(value)
+	if err != nil {
+		return YearMonth[T]{}, err
+	}
+	ym, ok := p.(iso8601.YearMonth)
+	if !ok {
+		return YearMonth[T]{}, &iso8601.UnexpectedTokenError{
+			Value:    value,
+			Token:    value,
+			Expected: "YYYY-MM",
+			Length:   len(value),
+			Kind:     iso8601.KindUnexpectedToken,
+		}
+	}
+	return YearMonthFromISO[T](ym), nil
+}
+
+// Year returns the year of ym.
+func (ym YearMonth[T]) Year() int { return ym.tm.Year() }
+
+// Month returns the month of the year of ym.
+func (ym YearMonth[T]) Month() time.Month { return ym.tm.Month() }
+
+// NumDays returns the number of days in ym.
+func (ym YearMonth[T]) NumDays() int { return daysIn(ym.Year(), ym.Month()) }
+
+// IsZero reports whether ym represents the zero value, January, year 1.
+func (ym YearMonth[T]) IsZero() bool { return ym.tm.IsZero() }
+
+// FirstDate returns the first date of ym.
+func (ym YearMonth[T]) FirstDate() Date[T] { return Date[T]{tm: ym.tm} }
+
+// LastDate returns the last date of ym.
+func (ym YearMonth[T]) LastDate() Date[T] { return ym.Next().FirstDate().AddDays(-1) }
+
+// Start returns start time in the month.
+func (ym YearMonth[T]) Start() Time[T] { return ym.FirstDate().StartOfDay() }
+
+// End returns end time in the month.
+func (ym YearMonth[T]) End() Time[T] { return ym.LastDate().EndOfDay() }
+
+// Days returns an iterator which yields the start of each day in the month.
+func (ym YearMonth[T]) Days() *Iterator[T] {
+	return Range(ym.Start(), ym.End(), iso8601.Duration{Day: 1})
+}
+
+// Contains reports whether t occurs in ym.
+func (ym YearMonth[T]) Contains(t Time[T]) bool {
+	return t.YearMonth() == ym
+}
+
+// Add returns the month n months after ym. n may be negative.
+func (ym YearMonth[T]) Add(n int) YearMonth[T] {
+	return YearMonth[T]{tm: ym.tm.AddDate(0, n, 0)}
+}
+
+// Next returns the month after ym.
+func (ym YearMonth[T]) Next() YearMonth[T] { return ym.Add(1) }
+
+// Prev returns the month before ym.
+func (ym YearMonth[T]) Prev() YearMonth[T] { return ym.Add(-1) }
+
+// Sub returns the number of months ym-u.
+func (ym YearMonth[T]) Sub(u YearMonth[T]) int {
+	return (ym.Year()-u.Year())*12 + int(ym.Month()-u.Month())
+}
+
+// After reports whether ym is after u.
+func (ym YearMonth[T]) After(u YearMonth[T]) bool { return ym.tm.After(u.tm) }
+
+// Before reports whether ym is before u.
+func (ym YearMonth[T]) Before(u YearMonth[T]) bool { return ym.tm.Before(u.tm) }
+
+// Equal reports whether ym and u represent the same month.
+func (ym YearMonth[T]) Equal(u YearMonth[T]) bool { return ym.tm.Equal(u.tm) }
+
+// Compare compares ym with u. If ym is before u, it returns -1;
+// if ym is after u, it returns +1; if they're the same, it returns 0.
+func (ym YearMonth[T]) Compare(u YearMonth[T]) int { return ym.tm.Compare(u.tm) }
+
+// String returns the ISO8601 string representation of the format "YYYY-MM".
+// For example: "2024-02".
+func (ym YearMonth[T]) String() string {
+	return ym.tm.Format("2006-01")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The month is formatted as "YYYY-MM".
+func (ym YearMonth[T]) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The month must be in the "YYYY-MM" format.
+func (ym *YearMonth[T]) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearMonth[T](string(data))
+	if err != nil {
+		return err
+	}
+	*ym = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The month is a quoted string in the "YYYY-MM" format.
+func (ym YearMonth[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ym.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The month must be a quoted string in the "YYYY-MM" format.
+func (ym *YearMonth[T]) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return ym.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,145 @@
+package synchro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ExampleYearMonth() {
+	ym := synchro.New[tz.AsiaTokyo](2024, 2, 10, 12, 0, 0, 0).YearMonth()
+	fmt.Println(ym)
+	fmt.Println(ym.Start())
+	fmt.Println(ym.End())
+	fmt.Println(ym.Next(), ym.Prev(), ym.Add(11))
+	// Output:
+	// 2024-02
+	// 2024-02-01 00:00:00 +0900 JST
+	// 2024-02-29 23:59:59.999999999 +0900 JST
+	// 2024-03 2024-01 2025-01
+}
+
+func TestNewYearMonth(t *testing.T) {
+	ym := synchro.NewYearMonth[tz.UTC](2023, 13)
+	if ym.Year() != 2024 || ym.Month() != time.January {
+		t.Errorf("want 2024-01 but got %s", ym)
+	}
+	if !(synchro.YearMonth[tz.UTC]{}).IsZero() {
+		t.Errorf("zero value should be zero")
+	}
+	if want := (synchro.Time[tz.UTC]{}).YearMonth(); !want.IsZero() {
+		t.Errorf("month of zero time should be zero, but got %s", want)
+	}
+	m := map[synchro.YearMonth[tz.UTC]]int{}
+	m[synchro.NewYearMonth[tz.UTC](2024, 2)]++
+	m[synchro.New[tz.UTC](2024, 2, 29, 23, 0, 0, 0).YearMonth()]++
+	if len(m) != 1 {
+		t.Errorf("want comparable month keys but got %v", m)
+	}
+}
+
+func TestYearMonth_Days(t *testing.T) {
+	tests := []struct {
+		ym   synchro.YearMonth[tz.AmericaNew_York]
+		want int
+	}{
+		{ym: synchro.NewYearMonth[tz.AmericaNew_York](2024, 2), want: 29},
+		{ym: synchro.NewYearMonth[tz.AmericaNew_York](2023, 2), want: 28},
+		{ym: synchro.NewYearMonth[tz.AmericaNew_York](2024, 3), want: 31},
+		{ym: synchro.NewYearMonth[tz.AmericaNew_York](2024, 11), want: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ym.String(), func(t *testing.T) {
+			if got := tt.ym.NumDays(); got != tt.want {
+				t.Errorf("NumDays: want %d but got %d", tt.want, got)
+			}
+			days := tt.ym.Days().Take(100)
+			if len(days) != tt.want {
+				t.Fatalf("Days: want %d but got %d", tt.want, len(days))
+			}
+			for i, d := range days {
+				if d.Day() != i+1 || d.Hour() != 0 {
+					t.Errorf("[%d] unexpected day: %v", i, d)
+				}
+			}
+		})
+	}
+}
+
+func TestYearMonth_Contains(t *testing.T) {
+	ym := synchro.NewYearMonth[tz.AsiaTokyo](2024, 2)
+	tests := []struct {
+		t    synchro.Time[tz.AsiaTokyo]
+		want bool
+	}{
+		{t: ym.Start(), want: true},
+		{t: ym.End(), want: true},
+		{t: ym.Start().Add(-time.Nanosecond), want: false},
+		{t: ym.End().Add(time.Nanosecond), want: false},
+		{t: synchro.New[tz.AsiaTokyo](2023, 2, 10, 0, 0, 0, 0), want: false},
+	}
+	for _, tt := range tests {
+		if got := ym.Contains(tt.t); got != tt.want {
+			t.Errorf("Contains(%v): want %v but got %v", tt.t, tt.want, got)
+		}
+	}
+}
+
+func TestYearMonth_Compare(t *testing.T) {
+	a := synchro.NewYearMonth[tz.UTC](2023, 12)
+	b := synchro.NewYearMonth[tz.UTC](2024, 2)
+	if !a.Before(b) || a.After(b) || a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("unexpected comparison between %s and %s", a, b)
+	}
+	if !a.Equal(b.Add(-2)) {
+		t.Errorf("want %s but got %s", a, b.Add(-2))
+	}
+	if got := b.Sub(a); got != 2 {
+		t.Errorf("want 2 but got %d", got)
+	}
+	if got := a.Sub(b); got != -2 {
+		t.Errorf("want -2 but got %d", got)
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	got, err := synchro.ParseYearMonth[tz.UTC]("2024-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.NewYearMonth[tz.UTC](2024, 2); got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	for _, value := range []string{"2024-13", "2024-2", "2024", "2024-02-01"} {
+		if _, err := synchro.ParseYearMonth[tz.UTC](value); err == nil {
+			t.Errorf("want error for %q", value)
+		}
+	}
+}
+
+func TestYearMonth_JSON(t *testing.T) {
+	type Invoice struct {
+		Month synchro.YearMonth[tz.UTC] `json:"month"`
+	}
+	b, err := json.Marshal(Invoice{Month: synchro.NewYearMonth[tz.UTC](2024, 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"month":"2024-02"}`; string(b) != want {
+		t.Errorf("want %s but got %s", want, b)
+	}
+	var got Invoice
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.NewYearMonth[tz.UTC](2024, 2); got.Month != want {
+		t.Errorf("want %s but got %s", want, got.Month)
+	}
+	if err := json.Unmarshal([]byte(`{"month":"2024-02-01"}`), &got); err == nil {
+		t.Errorf("want error")
+	}
+}